@@ -0,0 +1,152 @@
+// Package aggregate provides grouped aggregations (i.e. GROUP BY with reducers) and
+// pivot tables over slices. Every aggregation is computed in a single pass over the
+// input and its results are returned as ordered collections, which makes the output
+// deterministic.
+package aggregate
+
+import (
+	"github.com/thefuga/go-collections/kv/ordered"
+	"github.com/thefuga/go-collections/slice"
+)
+
+// Row holds the results of the aggregators of a single group, keyed by the aggregator
+// name and in the same order the aggregators were given.
+// Use ordered.Get to retrieve a result with its concrete type.
+type Row = ordered.Collection[string, any]
+
+// Grouping holds a slice and the function used to group its items. Nothing is
+// computed until one of its methods is called.
+type Grouping[V any, K comparable] struct {
+	items []V
+	key   func(v V) K
+}
+
+// GroupBy returns a Grouping of the slice items by the return value of key.
+// Groups are always yielded in the order their first item appears on the slice.
+func GroupBy[V any, K comparable](items []V, key func(v V) K) Grouping[V, K] {
+	return Grouping[V, K]{items: items, key: key}
+}
+
+// Groups returns the items of each group, preserving the order of the slice.
+func (g Grouping[V, K]) Groups() ordered.Collection[K, slice.Collection[V]] {
+	groups := ordered.CollectMap(map[K]slice.Collection[V]{})
+
+	for _, v := range g.items {
+		k := g.key(v)
+		groups.Put(k, groups.Get(k).Push(v))
+	}
+
+	return groups
+}
+
+// Agg computes every aggregator for each group in a single pass over the items.
+// The returned collection is keyed by group, each group holding a Row with the
+// aggregated results.
+func (g Grouping[V, K]) Agg(aggregators ...Aggregator[V]) ordered.Collection[K, Row] {
+	accumulators := ordered.CollectMap(map[K][]accumulator[V]{})
+
+	for _, v := range g.items {
+		k := g.key(v)
+
+		groupAccumulators, err := accumulators.GetE(k)
+		if err != nil {
+			groupAccumulators = newAccumulators(aggregators)
+			accumulators.Put(k, groupAccumulators)
+		}
+
+		for _, acc := range groupAccumulators {
+			acc.add(v)
+		}
+	}
+
+	rows := ordered.CollectMap(make(map[K]Row, accumulators.Count()))
+
+	accumulators.Each(func(k K, groupAccumulators []accumulator[V]) {
+		rows.Put(k, makeRow(aggregators, groupAccumulators))
+	})
+
+	return rows
+}
+
+// Count returns the number of items of each group.
+func (g Grouping[V, K]) Count() ordered.Collection[K, int] {
+	counts := ordered.CollectMap(map[K]int{})
+
+	for _, v := range g.items {
+		k := g.key(v)
+		counts.Put(k, counts.Get(k)+1)
+	}
+
+	return counts
+}
+
+// Pivot builds a two-dimensional table, grouping the items by row and column and
+// aggregating each cell with agg. Rows and columns are ordered by their first
+// appearance on the slice. Every row holds every column; cells without items hold
+// the result of an aggregator that received no values (e.g. 0 for Count).
+func Pivot[V any, R, C comparable](
+	items []V,
+	row func(v V) R,
+	column func(v V) C,
+	agg Aggregator[V],
+) ordered.Collection[R, ordered.Collection[C, any]] {
+	columns := ordered.CollectMap(map[C]struct{}{})
+	cells := ordered.CollectMap(map[R]map[C]accumulator[V]{})
+
+	for _, v := range items {
+		r, c := row(v), column(v)
+		columns.Put(c, struct{}{})
+
+		rowCells, err := cells.GetE(r)
+		if err != nil {
+			rowCells = map[C]accumulator[V]{}
+			cells.Put(r, rowCells)
+		}
+
+		acc, ok := rowCells[c]
+		if !ok {
+			acc = agg.newAccumulator()
+			rowCells[c] = acc
+		}
+
+		acc.add(v)
+	}
+
+	table := ordered.CollectMap(make(map[R]ordered.Collection[C, any], cells.Count()))
+
+	cells.Each(func(r R, rowCells map[C]accumulator[V]) {
+		tableRow := ordered.CollectMap(make(map[C]any, columns.Count()))
+
+		columns.Keys().Each(func(_ int, c C) {
+			acc, ok := rowCells[c]
+			if !ok {
+				acc = agg.newAccumulator()
+			}
+			tableRow.Put(c, acc.result())
+		})
+
+		table.Put(r, tableRow)
+	})
+
+	return table
+}
+
+func newAccumulators[V any](aggregators []Aggregator[V]) []accumulator[V] {
+	accumulators := make([]accumulator[V], len(aggregators))
+
+	for i, agg := range aggregators {
+		accumulators[i] = agg.newAccumulator()
+	}
+
+	return accumulators
+}
+
+func makeRow[V any](aggregators []Aggregator[V], accumulators []accumulator[V]) Row {
+	row := ordered.CollectMap(make(map[string]any, len(aggregators)))
+
+	for i, agg := range aggregators {
+		row.Put(agg.name, accumulators[i].result())
+	}
+
+	return row
+}
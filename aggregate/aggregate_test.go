@@ -0,0 +1,165 @@
+package aggregate
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thefuga/go-collections/kv/ordered"
+	"github.com/thefuga/go-collections/slice"
+)
+
+type sale struct {
+	Region  string
+	Product string
+	Units   int
+	Price   float64
+}
+
+var sales = []sale{
+	{"north", "apple", 10, 1.5},
+	{"south", "apple", 5, 1.0},
+	{"north", "pear", 3, 2.0},
+	{"north", "apple", 2, 2.5},
+	{"south", "plum", 7, 3.0},
+}
+
+func region(s sale) string  { return s.Region }
+func product(s sale) string { return s.Product }
+func units(s sale) int      { return s.Units }
+func price(s sale) float64  { return s.Price }
+
+func TestGroups(t *testing.T) {
+	groups := GroupBy(sales, region).Groups()
+
+	expectedKeys := slice.Collect("north", "south")
+	if keys := groups.Keys(); !reflect.DeepEqual(keys, expectedKeys) {
+		t.Errorf("expected keys to be %v. got %v", expectedKeys, keys)
+	}
+
+	expectedNorth := slice.Collect(sales[0], sales[2], sales[3])
+	if north := groups.Get("north"); !reflect.DeepEqual(north, expectedNorth) {
+		t.Errorf("expected north group to be %v. got %v", expectedNorth, north)
+	}
+}
+
+func TestCount(t *testing.T) {
+	counts := GroupBy(sales, product).Count()
+	expected := []int{3, 1, 1}
+
+	if values := counts.ToSlice(); !reflect.DeepEqual(values, expected) {
+		t.Errorf("expected counts to be %v. got %v", expected, values)
+	}
+}
+
+func TestAgg(t *testing.T) {
+	rows := GroupBy(sales, region).Agg(
+		Count[sale](),
+		SumBy(units),
+		AvgBy(price),
+		MinBy(units),
+		MaxBy(units),
+		MaxBy(product).As("last_product"),
+	)
+
+	testCases := []struct {
+		description string
+		group       string
+		aggregator  string
+		expected    any
+	}{
+		{"north count", "north", "count", 3},
+		{"north sum", "north", "sum", 15},
+		{"north avg", "north", "avg", 2.0},
+		{"north min", "north", "min", 2},
+		{"north max", "north", "max", 10},
+		{"north renamed max", "north", "last_product", "pear"},
+		{"south count", "south", "count", 2},
+		{"south sum", "south", "sum", 12},
+		{"south avg", "south", "avg", 2.0},
+		{"south min", "south", "min", 5},
+		{"south max", "south", "max", 7},
+		{"south renamed max", "south", "last_product", "plum"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			row := rows.Get(tc.group)
+
+			if v := row.Get(tc.aggregator); v != tc.expected {
+				t.Errorf("expected %v. got %v", tc.expected, v)
+			}
+		})
+	}
+
+	expectedNames := slice.Collect("count", "sum", "avg", "min", "max", "last_product")
+	if names := rows.Get("north").Keys(); !reflect.DeepEqual(names, expectedNames) {
+		t.Errorf("expected row keys to be %v. got %v", expectedNames, names)
+	}
+}
+
+func TestAggIsSinglePass(t *testing.T) {
+	calls := 0
+	counted := func(s sale) int {
+		calls++
+		return s.Units
+	}
+
+	GroupBy(sales, region).Agg(SumBy(counted))
+
+	if calls != len(sales) {
+		t.Errorf("expected key function to be called %d times. got %d", len(sales), calls)
+	}
+}
+
+func TestAggWithMultipleKeys(t *testing.T) {
+	rows := GroupBy(sales, Keys2(region, product)).Agg(SumBy(units))
+
+	expectedKeys := slice.Collect(
+		Key2[string, string]{"north", "apple"},
+		Key2[string, string]{"south", "apple"},
+		Key2[string, string]{"north", "pear"},
+		Key2[string, string]{"south", "plum"},
+	)
+
+	if keys := rows.Keys(); !reflect.DeepEqual(keys, expectedKeys) {
+		t.Errorf("expected keys to be %v. got %v", expectedKeys, keys)
+	}
+
+	sum, err := ordered.Get[string, int](rows.Get(Key2[string, string]{"north", "apple"}), "sum")
+	if err != nil || sum != 12 {
+		t.Errorf("expected sum to be 12. got %d (%v)", sum, err)
+	}
+}
+
+func TestAggOnEmptySlice(t *testing.T) {
+	rows := GroupBy([]sale{}, region).Agg(Count[sale]())
+
+	if !rows.IsEmpty() {
+		t.Errorf("expected no groups. got %d", rows.Count())
+	}
+}
+
+func TestPivot(t *testing.T) {
+	table := Pivot(sales, region, product, SumBy(units))
+
+	expectedRows := slice.Collect("north", "south")
+	if rows := table.Keys(); !reflect.DeepEqual(rows, expectedRows) {
+		t.Errorf("expected rows to be %v. got %v", expectedRows, rows)
+	}
+
+	expectedCells := map[string][]any{
+		"north": {12, 3, 0},
+		"south": {5, 0, 7},
+	}
+
+	table.Each(func(r string, row ordered.Collection[string, any]) {
+		expectedColumns := slice.Collect("apple", "pear", "plum")
+		if columns := row.Keys(); !reflect.DeepEqual(columns, expectedColumns) {
+			t.Errorf("expected columns to be %v. got %v", expectedColumns, columns)
+		}
+
+		if cells := row.ToSlice(); !reflect.DeepEqual(cells, expectedCells[r]) {
+			t.Errorf("expected %s cells to be %v. got %v", r, expectedCells[r], cells)
+		}
+	})
+}
@@ -0,0 +1,123 @@
+package aggregate
+
+import "github.com/thefuga/go-collections/internal"
+
+// Aggregator reduces the items of a group to a single result. Aggregators are
+// stateless and can be reused on multiple aggregations.
+type Aggregator[V any] struct {
+	name           string
+	newAccumulator func() accumulator[V]
+}
+
+type accumulator[V any] interface {
+	add(v V)
+	result() any
+}
+
+// As returns a copy of the aggregator which results will be stored under name.
+// It is needed when the same kind of aggregator is used more than once on the same
+// aggregation, as the later result would otherwise override the former.
+func (a Aggregator[V]) As(name string) Aggregator[V] {
+	a.name = name
+	return a
+}
+
+// Name returns the name under which the aggregator result is stored.
+func (a Aggregator[V]) Name() string { return a.name }
+
+// Count counts the items of each group. Its result is an int stored as "count".
+func Count[V any]() Aggregator[V] {
+	return Aggregator[V]{
+		name:           "count",
+		newAccumulator: func() accumulator[V] { return &countAccumulator[V]{} },
+	}
+}
+
+// SumBy sums the values returned by f. Its result is a T stored as "sum".
+func SumBy[V any, T internal.Number](f func(v V) T) Aggregator[V] {
+	return Aggregator[V]{
+		name:           "sum",
+		newAccumulator: func() accumulator[V] { return &sumAccumulator[V, T]{f: f} },
+	}
+}
+
+// AvgBy calculates the average of the values returned by f. Its result is a float64
+// stored as "avg". Groups without items have an average of 0.
+func AvgBy[V any, T internal.Number](f func(v V) T) Aggregator[V] {
+	return Aggregator[V]{
+		name:           "avg",
+		newAccumulator: func() accumulator[V] { return &avgAccumulator[V, T]{f: f} },
+	}
+}
+
+// MinBy finds the minimal value returned by f. Its result is a T stored as "min".
+// Groups without items have T's zeroed value as minimum.
+func MinBy[V any, T internal.Relational](f func(v V) T) Aggregator[V] {
+	return Aggregator[V]{
+		name: "min",
+		newAccumulator: func() accumulator[V] {
+			return &extremeAccumulator[V, T]{f: f, replaces: func(v, current T) bool { return v < current }}
+		},
+	}
+}
+
+// MaxBy finds the maximum value returned by f. Its result is a T stored as "max".
+// Groups without items have T's zeroed value as maximum.
+func MaxBy[V any, T internal.Relational](f func(v V) T) Aggregator[V] {
+	return Aggregator[V]{
+		name: "max",
+		newAccumulator: func() accumulator[V] {
+			return &extremeAccumulator[V, T]{f: f, replaces: func(v, current T) bool { return v > current }}
+		},
+	}
+}
+
+type countAccumulator[V any] struct{ count int }
+
+func (a *countAccumulator[V]) add(V)       { a.count++ }
+func (a *countAccumulator[V]) result() any { return a.count }
+
+type sumAccumulator[V any, T internal.Number] struct {
+	f   func(v V) T
+	sum T
+}
+
+func (a *sumAccumulator[V, T]) add(v V)     { a.sum += a.f(v) }
+func (a *sumAccumulator[V, T]) result() any { return a.sum }
+
+type avgAccumulator[V any, T internal.Number] struct {
+	f     func(v V) T
+	sum   float64
+	count int
+}
+
+func (a *avgAccumulator[V, T]) add(v V) {
+	a.sum += float64(a.f(v))
+	a.count++
+}
+
+func (a *avgAccumulator[V, T]) result() any {
+	if a.count == 0 {
+		return 0.0
+	}
+
+	return a.sum / float64(a.count)
+}
+
+type extremeAccumulator[V any, T internal.Relational] struct {
+	f        func(v V) T
+	replaces func(v, current T) bool
+	extreme  T
+	found    bool
+}
+
+func (a *extremeAccumulator[V, T]) add(v V) {
+	t := a.f(v)
+
+	if !a.found || a.replaces(t, a.extreme) {
+		a.extreme = t
+		a.found = true
+	}
+}
+
+func (a *extremeAccumulator[V, T]) result() any { return a.extreme }
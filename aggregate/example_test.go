@@ -0,0 +1,51 @@
+package aggregate
+
+import (
+	"fmt"
+
+	"github.com/thefuga/go-collections/kv/ordered"
+)
+
+func ExampleGrouping_Agg() {
+	type order struct {
+		Customer string
+		Total    float64
+	}
+
+	orders := []order{{"ana", 10}, {"bob", 5}, {"ana", 20}}
+
+	rows := GroupBy(orders, func(o order) string { return o.Customer }).
+		Agg(Count[order](), SumBy(func(o order) float64 { return o.Total }))
+
+	rows.Each(func(customer string, row Row) {
+		count, _ := ordered.Get[string, int](row, "count")
+		sum, _ := ordered.Get[string, float64](row, "sum")
+		fmt.Printf("%s: %d orders, %.2f\n", customer, count, sum)
+	})
+	// Output:
+	// ana: 2 orders, 30.00
+	// bob: 1 orders, 5.00
+}
+
+func ExamplePivot() {
+	type visit struct {
+		Day  string
+		Page string
+	}
+
+	visits := []visit{{"mon", "home"}, {"mon", "about"}, {"tue", "home"}, {"mon", "home"}}
+
+	table := Pivot(
+		visits,
+		func(v visit) string { return v.Day },
+		func(v visit) string { return v.Page },
+		Count[visit](),
+	)
+
+	table.Each(func(day string, row ordered.Collection[string, any]) {
+		fmt.Println(day, row.ToSlice())
+	})
+	// Output:
+	// mon [2 1]
+	// tue [1 0]
+}
@@ -0,0 +1,32 @@
+package aggregate
+
+// Key2 is a composite key used to group items by two values.
+type Key2[A, B comparable] struct {
+	First  A
+	Second B
+}
+
+// Key3 is a composite key used to group items by three values.
+type Key3[A, B, C comparable] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Keys2 composes two key functions into a single one, allowing items to be grouped
+// by multiple keys. E.g.: GroupBy(sales, Keys2(region, product)).
+func Keys2[V any, A, B comparable](a func(v V) A, b func(v V) B) func(v V) Key2[A, B] {
+	return func(v V) Key2[A, B] {
+		return Key2[A, B]{First: a(v), Second: b(v)}
+	}
+}
+
+// Keys3 composes three key functions into a single one, allowing items to be grouped
+// by multiple keys.
+func Keys3[V any, A, B, C comparable](
+	a func(v V) A, b func(v V) B, c func(v V) C,
+) func(v V) Key3[A, B, C] {
+	return func(v V) Key3[A, B, C] {
+		return Key3[A, B, C]{First: a(v), Second: b(v), Third: c(v)}
+	}
+}
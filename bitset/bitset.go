@@ -0,0 +1,323 @@
+// Package bitset provides a growable set of non-negative integers, stored as bits.
+package bitset
+
+import (
+	"encoding/binary"
+	"math/bits"
+
+	"github.com/thefuga/go-collections/errors"
+	"github.com/thefuga/go-collections/internal"
+	"github.com/thefuga/go-collections/slice"
+)
+
+const (
+	wordSize = 64
+	// encodingVersion is the first byte of the binary encoding.
+	encodingVersion = 1
+)
+
+// BitSet is a set of non-negative integers. Each integer takes a single bit, so
+// membership tests run in O(1) and set operations process 64 integers at a time.
+// The set grows as needed. The zero value is an empty set ready to use.
+type BitSet struct {
+	words []uint64
+}
+
+// New makes a BitSet holding the given integers. Negative integers are ignored.
+func New(values ...int) *BitSet {
+	return From(values)
+}
+
+// From makes a BitSet holding the given integers, such as the result of
+// collections.Range. Negative integers are ignored.
+func From[T internal.Integer](values []T) *BitSet {
+	b := &BitSet{}
+
+	for _, v := range values {
+		b.Set(int(v))
+	}
+
+	return b
+}
+
+// Set calls SetE, omitting the error.
+func (b *BitSet) Set(i int) *BitSet {
+	_ = b.SetE(i)
+	return b
+}
+
+// SetE adds i to the set, growing it as needed. Should i be negative, an instance of
+// errors.IndexOutOfBoundsError is returned.
+func (b *BitSet) SetE(i int) error {
+	if i < 0 {
+		return errors.NewIndexOutOfBoundsError()
+	}
+
+	b.grow(i/wordSize + 1)
+	b.words[i/wordSize] |= 1 << (uint(i) % wordSize)
+
+	return nil
+}
+
+// Clear removes i from the set.
+func (b *BitSet) Clear(i int) *BitSet {
+	if i >= 0 && i/wordSize < len(b.words) {
+		b.words[i/wordSize] &^= 1 << (uint(i) % wordSize)
+	}
+
+	return b
+}
+
+// Flip calls FlipE, omitting the error.
+func (b *BitSet) Flip(i int) *BitSet {
+	_ = b.FlipE(i)
+	return b
+}
+
+// FlipE adds i to the set when absent, or removes it otherwise. Should i be negative,
+// an instance of errors.IndexOutOfBoundsError is returned.
+func (b *BitSet) FlipE(i int) error {
+	if i < 0 {
+		return errors.NewIndexOutOfBoundsError()
+	}
+
+	b.grow(i/wordSize + 1)
+	b.words[i/wordSize] ^= 1 << (uint(i) % wordSize)
+
+	return nil
+}
+
+// Test checks if i is on the set.
+func (b *BitSet) Test(i int) bool {
+	if i < 0 || i/wordSize >= len(b.words) {
+		return false
+	}
+
+	return b.words[i/wordSize]&(1<<(uint(i)%wordSize)) != 0
+}
+
+// Count returns the number of integers on the set.
+func (b *BitSet) Count() int {
+	count := 0
+
+	for _, word := range b.words {
+		count += bits.OnesCount64(word)
+	}
+
+	return count
+}
+
+// IsEmpty checks if the set is empty.
+func (b *BitSet) IsEmpty() bool {
+	for _, word := range b.words {
+		if word != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals checks if both sets hold the same integers, regardless of their capacity.
+func (b *BitSet) Equals(other *BitSet) bool {
+	longest, shortest := b.words, other.words
+	if len(longest) < len(shortest) {
+		longest, shortest = shortest, longest
+	}
+
+	for i, word := range longest {
+		if i < len(shortest) {
+			if word != shortest[i] {
+				return false
+			}
+		} else if word != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Copy returns a copy of the set.
+func (b *BitSet) Copy() *BitSet {
+	copied := &BitSet{words: make([]uint64, len(b.words))}
+	copy(copied.words, b.words)
+
+	return copied
+}
+
+// Union returns a new set holding the integers of both sets.
+func (b *BitSet) Union(other *BitSet) *BitSet {
+	return b.combine(other, func(current, other uint64) uint64 { return current | other })
+}
+
+// Intersection returns a new set holding the integers present on both sets.
+func (b *BitSet) Intersection(other *BitSet) *BitSet {
+	return b.combine(other, func(current, other uint64) uint64 { return current & other })
+}
+
+// Difference returns a new set holding the integers of b not present on other.
+func (b *BitSet) Difference(other *BitSet) *BitSet {
+	return b.combine(other, func(current, other uint64) uint64 { return current &^ other })
+}
+
+// SymmetricDifference returns a new set holding the integers present on only one of
+// the sets.
+func (b *BitSet) SymmetricDifference(other *BitSet) *BitSet {
+	return b.combine(other, func(current, other uint64) uint64 { return current ^ other })
+}
+
+// NextSet returns the lowest integer on the set greater than or equal to from, and
+// whether there is one.
+func (b *BitSet) NextSet(from int) (int, bool) {
+	if from < 0 {
+		from = 0
+	}
+
+	i := from / wordSize
+	if i >= len(b.words) {
+		return 0, false
+	}
+
+	// Discard the bits below from on its word.
+	word := b.words[i] >> (uint(from) % wordSize)
+	if word != 0 {
+		return from + bits.TrailingZeros64(word), true
+	}
+
+	for i++; i < len(b.words); i++ {
+		if b.words[i] != 0 {
+			return i*wordSize + bits.TrailingZeros64(b.words[i]), true
+		}
+	}
+
+	return 0, false
+}
+
+// NextClear returns the lowest integer not on the set greater than or equal to from.
+func (b *BitSet) NextClear(from int) int {
+	if from < 0 {
+		from = 0
+	}
+
+	i := from / wordSize
+	if i >= len(b.words) {
+		return from
+	}
+
+	word := ^b.words[i] >> (uint(from) % wordSize)
+	if word != 0 {
+		return from + bits.TrailingZeros64(word)
+	}
+
+	for i++; i < len(b.words); i++ {
+		if b.words[i] != ^uint64(0) {
+			return i*wordSize + bits.TrailingZeros64(^b.words[i])
+		}
+	}
+
+	return len(b.words) * wordSize
+}
+
+// Each calls f with every integer on the set, in ascending order.
+func (b *BitSet) Each(f func(i int)) *BitSet {
+	for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {
+		f(i)
+	}
+
+	return b
+}
+
+// ToSliceCollection returns the integers on the set, in ascending order.
+func (b *BitSet) ToSliceCollection() slice.Collection[int] {
+	values := make(slice.Collection[int], 0, b.Count())
+
+	b.Each(func(i int) {
+		values = values.Push(i)
+	})
+
+	return values
+}
+
+// MarshalBinary encodes the set as a version byte followed by its words, 8 little
+// endian bytes each. Trailing empty words are omitted.
+func (b *BitSet) MarshalBinary() ([]byte, error) {
+	words := b.words
+	for len(words) > 0 && words[len(words)-1] == 0 {
+		words = words[:len(words)-1]
+	}
+
+	data := make([]byte, 1, 1+8*len(words))
+	data[0] = encodingVersion
+
+	for _, word := range words {
+		data = binary.LittleEndian.AppendUint64(data, word)
+	}
+
+	return data, nil
+}
+
+// UnmarshalBinary decodes data encoded by MarshalBinary, replacing the set contents.
+// Should data use another version, an instance of errors.UnsupportedVersionError is
+// returned. Should data be malformed, an instance of errors.InvalidEncodingError is
+// returned.
+func (b *BitSet) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.NewInvalidEncodingError()
+	}
+
+	if data[0] != encodingVersion {
+		return errors.NewUnsupportedVersionError(data[0])
+	}
+
+	data = data[1:]
+
+	if len(data)%8 != 0 {
+		return errors.NewInvalidEncodingError()
+	}
+
+	words := make([]uint64, len(data)/8)
+
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(data[i*8:])
+	}
+
+	b.words = words
+
+	return nil
+}
+
+func (b *BitSet) combine(other *BitSet, f func(current, other uint64) uint64) *BitSet {
+	combined := &BitSet{words: make([]uint64, internal.Max(len(b.words), len(other.words)))}
+
+	for i := range combined.words {
+		var current, next uint64
+
+		if i < len(b.words) {
+			current = b.words[i]
+		}
+
+		if i < len(other.words) {
+			next = other.words[i]
+		}
+
+		combined.words[i] = f(current, next)
+	}
+
+	return combined
+}
+
+func (b *BitSet) grow(words int) {
+	if words <= len(b.words) {
+		return
+	}
+
+	if words <= cap(b.words) {
+		b.words = b.words[:words]
+		return
+	}
+
+	grown := make([]uint64, words, internal.Max(words, 2*cap(b.words)))
+	copy(grown, b.words)
+	b.words = grown
+}
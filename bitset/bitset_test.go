@@ -0,0 +1,164 @@
+package bitset
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thefuga/go-collections"
+	"github.com/thefuga/go-collections/slice"
+)
+
+func TestSetClearFlip(t *testing.T) {
+	var b BitSet
+
+	b.Set(1).Set(64).Set(200).Flip(3).Flip(1).Clear(64).Clear(1000).Clear(-1)
+
+	if expected := slice.Collect(3, 200); !reflect.DeepEqual(b.ToSliceCollection(), expected) {
+		t.Errorf("expected %v. got %v", expected, b.ToSliceCollection())
+	}
+
+	if !b.Test(200) || b.Test(64) || b.Test(-1) || b.Test(5000) {
+		t.Error("unexpected Test results")
+	}
+
+	if err := b.SetE(-1); err == nil || err.Error() != "index out of bounds" {
+		t.Errorf("expected an index out of bounds error. got %v", err)
+	}
+
+	if err := b.FlipE(-1); err == nil {
+		t.Error("expected an index out of bounds error")
+	}
+
+	if b.Count() != 2 || b.IsEmpty() {
+		t.Errorf("expected count to be 2. got %d", b.Count())
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	left := New(1, 2, 3, 100)
+	right := New(2, 3, 4, 300)
+
+	testCases := []struct {
+		description string
+		result      *BitSet
+		expected    slice.Collection[int]
+	}{
+		{"union", left.Union(right), slice.Collect(1, 2, 3, 4, 100, 300)},
+		{"intersection", left.Intersection(right), slice.Collect(2, 3)},
+		{"difference", left.Difference(right), slice.Collect(1, 100)},
+		{"symmetric difference", left.SymmetricDifference(right), slice.Collect(1, 4, 100, 300)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if !reflect.DeepEqual(tc.result.ToSliceCollection(), tc.expected) {
+				t.Errorf("expected %v. got %v", tc.expected, tc.result.ToSliceCollection())
+			}
+		})
+	}
+
+	if left.Count() != 4 || right.Count() != 4 {
+		t.Error("set operations must not modify the operands")
+	}
+}
+
+func TestNextSetAndNextClear(t *testing.T) {
+	b := From(collections.Range(60, 130)).Set(200)
+
+	testCases := []struct {
+		description string
+		from        int
+		set         int
+		found       bool
+		clear       int
+	}{
+		{"before every integer", 0, 60, true, 0},
+		{"negative", -5, 60, true, 0},
+		{"inside a run", 70, 70, true, 131},
+		{"word boundary", 64, 64, true, 131},
+		{"between runs", 131, 200, true, 131},
+		{"last integer", 200, 200, true, 201},
+		{"after every integer", 201, 0, false, 201},
+		{"beyond capacity", 5000, 0, false, 5000},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if set, found := b.NextSet(tc.from); set != tc.set || found != tc.found {
+				t.Errorf("expected next set to be %d (%v). got %d (%v)", tc.set, tc.found, set, found)
+			}
+
+			if clear := b.NextClear(tc.from); clear != tc.clear {
+				t.Errorf("expected next clear to be %d. got %d", tc.clear, clear)
+			}
+		})
+	}
+
+	full := From(collections.Range(0, 127))
+	if clear := full.NextClear(0); clear != 128 {
+		t.Errorf("expected next clear of a full set to be 128. got %d", clear)
+	}
+}
+
+func TestEquals(t *testing.T) {
+	b := New(1, 500).Clear(500)
+
+	if !b.Equals(New(1)) || !New(1).Equals(b) || b.Equals(New(2)) {
+		t.Error("sets must be compared regardless of their capacity")
+	}
+
+	copied := b.Copy().Set(2)
+	if b.Test(2) {
+		t.Error("copies must not share storage")
+	}
+
+	if !copied.Equals(New(1, 2)) {
+		t.Errorf("unexpected copy %v", copied.ToSliceCollection())
+	}
+}
+
+func TestBinaryEncoding(t *testing.T) {
+	b := New(0, 63, 64, 1000).Set(5000).Clear(5000)
+
+	data, err := b.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	var decoded BitSet
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if !decoded.Equals(b) || len(decoded.words) != 16 {
+		t.Errorf("expected %v. got %v", b.ToSliceCollection(), decoded.ToSliceCollection())
+	}
+
+	dense := New()
+	for i := 0; i < 6400; i++ {
+		dense.Set(i)
+	}
+
+	if data, _ := dense.MarshalBinary(); len(data) != 1+8*100 {
+		t.Errorf("expected a dense set of 100 words to take %d bytes. got %d", 1+8*100, len(data))
+	}
+
+	testCases := []struct {
+		description string
+		data        []byte
+		err         string
+	}{
+		{"empty", nil, "invalid encoding"},
+		{"unknown version", []byte{9, 0}, "unsupported encoding version '9'"},
+		{"truncated", data[:len(data)-1], "invalid encoding"},
+		{"trailing bytes", append(data[:len(data):len(data)], 0), "invalid encoding"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if err := new(BitSet).UnmarshalBinary(tc.data); err == nil || err.Error() != tc.err {
+				t.Errorf("expected error to be %s. got %v", tc.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package bitset
+
+import "fmt"
+
+func ExampleBitSet() {
+	read, write := New(1, 2, 3), New(2, 3, 4)
+
+	fmt.Println(read.Intersection(write).ToSliceCollection())
+	fmt.Println(read.Test(4), write.Test(4))
+	// Output:
+	// [2 3]
+	// false true
+}
+
+func ExampleBitSet_Format() {
+	fmt.Printf("%v %.2v\n", New(70, 3, 1), New(70, 3, 1))
+	// Output:
+	// {1 3 70} {1 3 ...}
+}
@@ -0,0 +1,25 @@
+package bitset
+
+import (
+	"fmt"
+
+	"github.com/thefuga/go-collections/internal"
+)
+
+// String returns the set formatted with %v.
+func (b *BitSet) String() string { return fmt.Sprint(b) }
+
+// Format implements fmt.Formatter, printing the set values in ascending order. %v
+// prints the values in a single line, %+v prints one value per line and %#v prints
+// the set in Go syntax. The precision limits the number of values printed
+// (e.g. %.10v).
+func (b *BitSet) Format(s fmt.State, verb rune) {
+	internal.Format(s, verb, b, internal.Formatter{
+		Open:  "{",
+		Close: "}",
+		Each: func(f func(k, v any) bool) {
+			for i, ok := b.NextSet(0); ok && f(i, i); i, ok = b.NextSet(i + 1) {
+			}
+		},
+	})
+}
@@ -0,0 +1,41 @@
+package bitset
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Value implements driver.Valuer, storing the set as a JSON array of its integers.
+func (b *BitSet) Value() (driver.Value, error) {
+	return json.Marshal(b.ToSliceCollection())
+}
+
+// Scan implements sql.Scanner, reading sets stored by Value. NULL is read as an empty set.
+func (b *BitSet) Scan(src any) error {
+	var values []int
+
+	switch data := src.(type) {
+	case nil:
+	case []byte:
+		if err := json.Unmarshal(data, &values); err != nil {
+			return err
+		}
+	case string:
+		if err := json.Unmarshal([]byte(data), &values); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("bitset: can't scan %T into %T", src, b)
+	}
+
+	*b = BitSet{}
+
+	for _, v := range values {
+		if err := b.SetE(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+// Package cache provides generic in-memory caches with different eviction policies.
+// Every cache is bounded by the capacity it is made with. Should the capacity be 0 or
+// lower, the cache is unbounded and only evicts entries on expiration. Caches are not
+// safe for concurrent use. See Synchronized.
+//
+// Caches keep their entries in linked lists rather than in ordered collections, whose
+// removals run in O(n), so that every operation runs in O(1). ToOrdered exports the
+// entries as an ordered collection, in eviction order.
+package cache
+
+import (
+	"time"
+
+	"github.com/thefuga/go-collections/kv/ordered"
+)
+
+// Cache is the method set shared by every cache of this package.
+type Cache[K comparable, V any] interface {
+	// Get calls GetE, omitting the error.
+	Get(k K) V
+	// GetE returns the value cached for k, counting as a hit or a miss on the cache
+	// stats. Should k not be cached, an errors.KeyNotFoundError is returned.
+	GetE(k K) (V, error)
+	// Peek calls PeekE, omitting the error.
+	Peek(k K) V
+	// PeekE behaves like GetE without affecting evictions or stats.
+	PeekE(k K) (V, error)
+	// Put caches v for k, evicting entries as needed.
+	Put(k K, v V)
+	// Forget calls ForgetE, omitting the error.
+	Forget(k K)
+	// ForgetE removes k from the cache. Should k not be cached, an errors.KeyNotFoundError
+	// is returned.
+	ForgetE(k K) error
+	// Len returns the number of cached entries.
+	Len() int
+	// Stats returns the cache statistics.
+	Stats() Stats
+	// ToOrdered returns the cached entries ordered from the next to be evicted to the last.
+	ToOrdered() ordered.Collection[K, V]
+}
+
+// Stats holds the cache hits, misses and evictions since it was made.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+// HitRatio returns the ratio of hits over every lookup, or 0 when there were no lookups.
+func (s Stats) HitRatio() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// EvictionReason tells why an entry left the cache.
+type EvictionReason int
+
+const (
+	// Capacity means the entry was evicted to make room for another one.
+	Capacity EvictionReason = iota
+	// Expired means the entry outlived its time to live.
+	Expired
+	// Removed means the entry was removed with Forget.
+	Removed
+)
+
+// String returns the name of the reason.
+func (r EvictionReason) String() string {
+	switch r {
+	case Capacity:
+		return "capacity"
+	case Expired:
+		return "expired"
+	case Removed:
+		return "removed"
+	}
+
+	return "unknown"
+}
+
+// Clock tells the current time to caches which expire entries.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is the default Clock, using time.Now.
+type SystemClock struct{}
+
+// Now returns time.Now.
+func (SystemClock) Now() time.Time { return time.Now() }
+
+// ManualClock is a Clock which only moves when told to, making tests deterministic.
+type ManualClock struct {
+	now time.Time
+}
+
+// NewManualClock makes a ManualClock set to now.
+func NewManualClock(now time.Time) *ManualClock { return &ManualClock{now: now} }
+
+// Now returns the time the clock is set to.
+func (c *ManualClock) Now() time.Time { return c.now }
+
+// Advance moves the clock forward by d.
+func (c *ManualClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+// Set sets the clock to now.
+func (c *ManualClock) Set(now time.Time) { c.now = now }
+
+// Option configures a cache.
+type Option[K comparable, V any] func(*options[K, V])
+
+type options[K comparable, V any] struct {
+	onEvict func(k K, v V, reason EvictionReason)
+	clock   Clock
+}
+
+// WithOnEvict sets a callback called every time an entry leaves the cache.
+func WithOnEvict[K comparable, V any](f func(k K, v V, reason EvictionReason)) Option[K, V] {
+	return func(o *options[K, V]) { o.onEvict = f }
+}
+
+// WithClock sets the clock used to expire entries. SystemClock is the default.
+func WithClock[K comparable, V any](clock Clock) Option[K, V] {
+	return func(o *options[K, V]) { o.clock = clock }
+}
+
+func newOptions[K comparable, V any](opts []Option[K, V]) options[K, V] {
+	o := options[K, V]{clock: SystemClock{}}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// evict counts the eviction and calls the eviction callback.
+func (o options[K, V]) evict(stats *Stats, k K, v V, reason EvictionReason) {
+	if reason != Removed {
+		stats.Evictions++
+	}
+
+	if o.onEvict != nil {
+		o.onEvict(k, v, reason)
+	}
+}
+
+var (
+	_ Cache[int, int] = (*LRU[int, int])(nil)
+	_ Cache[int, int] = (*LFU[int, int])(nil)
+	_ Cache[int, int] = (*TTL[int, int])(nil)
+)
@@ -0,0 +1,306 @@
+package cache
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+type eviction struct {
+	key    string
+	reason EvictionReason
+}
+
+func recorder() (*[]eviction, Option[string, int]) {
+	var evictions []eviction
+
+	return &evictions, WithOnEvict(func(k string, _ int, reason EvictionReason) {
+		evictions = append(evictions, eviction{k, reason})
+	})
+}
+
+func TestLRU(t *testing.T) {
+	evictions, onEvict := recorder()
+	c := NewLRU(2, onEvict)
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Put("c", 3)
+
+	if _, err := c.GetE("b"); err == nil || err.Error() != "key 'b' not found" {
+		t.Errorf("expected b to be evicted. got %v", err)
+	}
+
+	if expected := []string{"a", "c"}; !reflect.DeepEqual([]string(c.ToOrdered().Keys()), expected) {
+		t.Errorf("expected %v. got %v", expected, c.ToOrdered().Keys())
+	}
+
+	c.Peek("a")
+	c.Put("d", 4)
+
+	if expected := []eviction{{"b", Capacity}, {"a", Capacity}}; !reflect.DeepEqual(*evictions, expected) {
+		t.Errorf("expected %v. got %v", expected, *evictions)
+	}
+
+	if expected := (Stats{Hits: 1, Misses: 1, Evictions: 2}); c.Stats() != expected {
+		t.Errorf("expected %v. got %v", expected, c.Stats())
+	}
+
+	c.Forget("c")
+
+	if c.Len() != 1 || c.ForgetE("c") == nil {
+		t.Error("expected c to be removed once")
+	}
+
+	if (*evictions)[2] != (eviction{"c", Removed}) {
+		t.Errorf("expected c removal to be reported. got %v", *evictions)
+	}
+}
+
+func TestLFU(t *testing.T) {
+	evictions, onEvict := recorder()
+	c := NewLFU(3, onEvict)
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	c.Put("d", 4)
+
+	if expected := []eviction{{"c", Capacity}}; !reflect.DeepEqual(*evictions, expected) {
+		t.Errorf("expected %v. got %v", expected, *evictions)
+	}
+
+	if expected := []string{"d", "b", "a"}; !reflect.DeepEqual([]string(c.ToOrdered().Keys()), expected) {
+		t.Errorf("expected %v. got %v", expected, c.ToOrdered().Keys())
+	}
+
+	c.Get("d")
+	c.Put("e", 5)
+
+	if c.Peek("b") != 0 || c.Peek("d") != 4 {
+		t.Error("expected b to be evicted as the least recently used of the least frequent")
+	}
+
+	c.Forget("e")
+	c.Put("f", 6)
+	c.Put("g", 7)
+
+	if expected := []string{"g", "d", "a"}; !reflect.DeepEqual([]string(c.ToOrdered().Keys()), expected) {
+		t.Errorf("expected %v. got %v", expected, c.ToOrdered().Keys())
+	}
+}
+
+func TestLFURemovingLeastFrequent(t *testing.T) {
+	c := NewLFU[string, int](2)
+
+	c.Put("a", 1)
+	c.Get("a")
+	c.Put("b", 2)
+	c.Get("b")
+	c.Get("b")
+	c.Forget("a")
+	c.Put("c", 3)
+	c.Forget("c")
+	c.Put("d", 4)
+	c.Put("e", 5)
+
+	if expected := []string{"e", "b"}; !reflect.DeepEqual([]string(c.ToOrdered().Keys()), expected) {
+		t.Errorf("expected %v. got %v", expected, c.ToOrdered().Keys())
+	}
+}
+
+func TestTTL(t *testing.T) {
+	clock := NewManualClock(time.Unix(0, 0))
+	evictions, onEvict := recorder()
+	c := NewTTL(2, time.Minute, onEvict, WithClock[string, int](clock))
+
+	c.Put("a", 1)
+	clock.Advance(30 * time.Second)
+	c.Put("b", 2)
+
+	if c.Get("a") != 1 {
+		t.Error("expected a to be cached")
+	}
+
+	clock.Advance(30 * time.Second)
+
+	if _, err := c.GetE("a"); err == nil {
+		t.Error("expected a to be expired")
+	}
+
+	c.Put("b", 3)
+	clock.Advance(45 * time.Second)
+	c.Put("c", 4)
+	c.Put("d", 5)
+
+	if expected := []string{"c", "d"}; !reflect.DeepEqual([]string(c.ToOrdered().Keys()), expected) {
+		t.Errorf("expected %v. got %v", expected, c.ToOrdered().Keys())
+	}
+
+	clock.Advance(time.Hour)
+
+	if c.Len() != 0 {
+		t.Errorf("expected every entry to be expired. got %d", c.Len())
+	}
+
+	expected := []eviction{{"a", Expired}, {"b", Capacity}, {"c", Expired}, {"d", Expired}}
+	if !reflect.DeepEqual(*evictions, expected) {
+		t.Errorf("expected %v. got %v", expected, *evictions)
+	}
+
+	if expected := (Stats{Hits: 1, Misses: 1, Evictions: 4}); c.Stats() != expected {
+		t.Errorf("expected %v. got %v", expected, c.Stats())
+	}
+}
+
+func TestSynchronized(t *testing.T) {
+	c := Synchronized(func(opts ...Option[string, int]) Cache[string, int] { return NewLRU(100, opts...) })
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				k := fmt.Sprint(j)
+				c.Put(k, j)
+				c.Get(k)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	if c.Len() != 100 || c.Stats().Hits+c.Stats().Misses != 800 {
+		t.Errorf("unexpected length %d or stats %v", c.Len(), c.Stats())
+	}
+}
+
+func TestSynchronizedEvictionCallbacks(t *testing.T) {
+	var (
+		c       Cache[string, int]
+		lengths []int
+	)
+
+	c = Synchronized(func(opts ...Option[string, int]) Cache[string, int] {
+		return NewLRU(1, opts...)
+	}, WithOnEvict(func(string, int, EvictionReason) {
+		lengths = append(lengths, c.Len())
+	}))
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Forget("b")
+
+	if expected := []int{1, 0}; !reflect.DeepEqual(lengths, expected) {
+		t.Errorf("expected %v. got %v", expected, lengths)
+	}
+}
+
+func TestTTLPeekExpired(t *testing.T) {
+	clock := NewManualClock(time.Unix(0, 0))
+	evictions, onEvict := recorder()
+	c := NewTTL(2, time.Minute, onEvict, WithClock[string, int](clock))
+
+	c.Put("a", 1)
+	clock.Advance(time.Minute)
+
+	if _, err := c.PeekE("a"); err == nil {
+		t.Error("expected a to be reported as not found")
+	}
+
+	if len(*evictions) != 0 || c.Stats().Evictions != 0 {
+		t.Errorf("expected peeking not to evict. got %v", *evictions)
+	}
+
+	if _, err := c.GetE("a"); err == nil || len(*evictions) != 1 {
+		t.Errorf("expected getting a to evict it. got %v", *evictions)
+	}
+}
+
+func TestUnboundedCapacity(t *testing.T) {
+	testCases := []struct {
+		description string
+		cache       Cache[int, int]
+	}{
+		{"lru", NewLRU[int, int](0)},
+		{"lfu", NewLFU[int, int](-1)},
+		{"ttl", NewTTL[int, int](0, time.Hour)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				tc.cache.Put(i, i)
+			}
+
+			if tc.cache.Len() != 100 || tc.cache.Stats().Evictions != 0 {
+				t.Errorf("expected 100 entries and no evictions. got %d and %v", tc.cache.Len(), tc.cache.Stats())
+			}
+		})
+	}
+}
+
+func TestStatsHitRatio(t *testing.T) {
+	if (Stats{}).HitRatio() != 0 || (Stats{Hits: 3, Misses: 1}).HitRatio() != 0.75 {
+		t.Error("unexpected hit ratio")
+	}
+}
+
+func TestFormat(t *testing.T) {
+	clock := NewManualClock(time.Unix(0, 0))
+	ttl := NewTTL(0, time.Minute, WithClock[string, int](clock))
+	ttl.Put("a", 1)
+	clock.Advance(30 * time.Second)
+	ttl.Put("b", 2)
+	clock.Advance(30 * time.Second)
+
+	lfu := NewLFU[string, int](2)
+	lfu.Put("a", 1)
+	lfu.Put("b", 2)
+	lfu.Get("a")
+
+	testCases := []struct {
+		description string
+		format      string
+		cache       any
+		expected    string
+	}{
+		{"lru", "%v", lruOf("a", "b", "c"), "{b:2 c:3}"},
+		{"truncated", "%.1v", lruOf("a", "b", "c"), "{b:2 ...}"},
+		{"multi line", "%+v", lruOf("a", "b"), "{\n  a: 1\n  b: 2\n}"},
+		{"lfu", "%v", lfu, "{b:2 a:1}"},
+		{"ttl skipping expired", "%v", ttl, "{b:2}"},
+		{"synchronized", "%v", Synchronized(func(...Option[string, int]) Cache[string, int] { return lruOf("a", "b") }), "{a:1 b:2}"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if actual := fmt.Sprintf(tc.format, tc.cache); actual != tc.expected {
+				t.Errorf("expected %q. got %q", tc.expected, actual)
+			}
+		})
+	}
+
+	if ttl.Stats().Evictions != 0 || ttl.expiry.Len() != 2 {
+		t.Error("expected formatting not to evict expired entries")
+	}
+}
+
+func lruOf(keys ...string) *LRU[string, int] {
+	c := NewLRU[string, int](2)
+
+	for i, k := range keys {
+		c.Put(k, i+1)
+	}
+
+	return c
+}
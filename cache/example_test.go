@@ -0,0 +1,19 @@
+package cache
+
+import "fmt"
+
+func ExampleLRU() {
+	c := NewLRU(2, WithOnEvict(func(k string, v int, reason EvictionReason) {
+		fmt.Printf("evicted %s (%s)\n", k, reason)
+	}))
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Put("c", 3)
+
+	fmt.Println(c.ToOrdered().Keys())
+	// Output:
+	// evicted b (capacity)
+	// [a c]
+}
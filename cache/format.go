@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/thefuga/go-collections/internal"
+	"github.com/thefuga/go-collections/kv/ordered"
+)
+
+// String returns the cache formatted with %v.
+func (c *LRU[K, V]) String() string { return fmt.Sprint(c) }
+
+// Format implements fmt.Formatter, printing the entries from the least to the most
+// recently used. %v prints the entries in a single line, %+v prints one entry per line
+// and %#v prints the cache in Go syntax. The precision limits the number of entries
+// printed (e.g. %.10v). Formatting doesn't affect evictions or stats.
+func (c *LRU[K, V]) Format(s fmt.State, verb rune) { format(s, verb, c, c.ToOrdered()) }
+
+// String returns the cache formatted with %v.
+func (c *LFU[K, V]) String() string { return fmt.Sprint(c) }
+
+// Format implements fmt.Formatter, printing the entries from the least to the most
+// frequently used, the same way LRU does.
+func (c *LFU[K, V]) Format(s fmt.State, verb rune) { format(s, verb, c, c.ToOrdered()) }
+
+// String returns the cache formatted with %v.
+func (c *TTL[K, V]) String() string { return fmt.Sprint(c) }
+
+// Format implements fmt.Formatter, printing the entries not expired from the closest to
+// expiring to the farthest, the same way LRU does. Expired entries are left to be
+// evicted by the other methods.
+func (c *TTL[K, V]) Format(s fmt.State, verb rune) { format(s, verb, c, c.live()) }
+
+// String returns the cache formatted with %v.
+func (s *synchronized[K, V]) String() string { return fmt.Sprint(s) }
+
+// Format implements fmt.Formatter, holding the lock while the wrapped cache is formatted.
+// Caches not implementing fmt.Formatter are printed the same way LRU is.
+func (s *synchronized[K, V]) Format(state fmt.State, verb rune) {
+	s.mu.Lock()
+	defer s.unlock()
+
+	if formatter, ok := s.cache.(fmt.Formatter); ok {
+		formatter.Format(state, verb)
+		return
+	}
+
+	format(state, verb, s.cache, s.cache.ToOrdered())
+}
+
+func format[K comparable, V any](s fmt.State, verb rune, c any, entries ordered.Collection[K, V]) {
+	internal.Format(s, verb, c, internal.Formatter{
+		Open:  "{",
+		Close: "}",
+		Keyed: true,
+		Each: func(f func(k, v any) bool) {
+			entries.EachWhile(func(k K, v V) bool { return f(k, v) })
+		},
+	})
+}
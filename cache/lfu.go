@@ -0,0 +1,171 @@
+package cache
+
+import (
+	"container/list"
+
+	"github.com/thefuga/go-collections/errors"
+	"github.com/thefuga/go-collections/kv/ordered"
+)
+
+type lfuEntry[K comparable, V any] struct {
+	entry[K, V]
+	// bucket is the element of the frequency bucket holding the entry.
+	bucket *list.Element
+}
+
+// frequencyBucket holds the entries used the same number of times, from the least to
+// the most recently used.
+type frequencyBucket struct {
+	frequency int
+	entries   *list.List
+}
+
+// LFU is a cache evicting the least frequently used entry once full. Ties are broken
+// by evicting the least recently used entry among them. Every operation runs in O(1).
+type LFU[K comparable, V any] struct {
+	capacity int
+	// buckets holds the frequency buckets in ascending frequency, without empty ones.
+	buckets *list.List
+	entries map[K]*list.Element
+	stats   Stats
+	options options[K, V]
+}
+
+// NewLFU makes an empty LFU cache holding up to capacity entries. Should capacity be
+// 0 or lower, the cache is unbounded.
+func NewLFU[K comparable, V any](capacity int, opts ...Option[K, V]) *LFU[K, V] {
+	return &LFU[K, V]{
+		capacity: capacity,
+		buckets:  list.New(),
+		entries:  make(map[K]*list.Element),
+		options:  newOptions(opts),
+	}
+}
+
+// Get calls GetE, omitting the error.
+func (c *LFU[K, V]) Get(k K) V {
+	v, _ := c.GetE(k)
+	return v
+}
+
+// GetE returns the value cached for k, incrementing its use frequency.
+func (c *LFU[K, V]) GetE(k K) (V, error) {
+	element, ok := c.entries[k]
+	if !ok {
+		c.stats.Misses++
+		return *new(V), errors.NewKeyNotFoundError(k)
+	}
+
+	c.stats.Hits++
+
+	return c.touch(element).value, nil
+}
+
+// Peek calls PeekE, omitting the error.
+func (c *LFU[K, V]) Peek(k K) V {
+	v, _ := c.PeekE(k)
+	return v
+}
+
+// PeekE returns the value cached for k without incrementing its use frequency.
+func (c *LFU[K, V]) PeekE(k K) (V, error) {
+	element, ok := c.entries[k]
+	if !ok {
+		return *new(V), errors.NewKeyNotFoundError(k)
+	}
+
+	return element.Value.(*lfuEntry[K, V]).value, nil
+}
+
+// Put caches v for k. Replacing a value counts as a use of k. Should the cache be full,
+// the least frequently used entry is evicted.
+func (c *LFU[K, V]) Put(k K, v V) {
+	if element, ok := c.entries[k]; ok {
+		c.touch(element).value = v
+		return
+	}
+
+	if c.capacity > 0 && len(c.entries) >= c.capacity {
+		c.remove(c.buckets.Front().Value.(*frequencyBucket).entries.Front(), Capacity)
+	}
+
+	first := c.buckets.Front()
+	if first == nil || first.Value.(*frequencyBucket).frequency != 1 {
+		first = c.buckets.PushFront(&frequencyBucket{frequency: 1, entries: list.New()})
+	}
+
+	e := &lfuEntry[K, V]{entry: entry[K, V]{key: k, value: v}, bucket: first}
+	c.entries[k] = first.Value.(*frequencyBucket).entries.PushBack(e)
+}
+
+// Forget calls ForgetE, omitting the error.
+func (c *LFU[K, V]) Forget(k K) { _ = c.ForgetE(k) }
+
+// ForgetE removes k from the cache.
+func (c *LFU[K, V]) ForgetE(k K) error {
+	element, ok := c.entries[k]
+	if !ok {
+		return errors.NewKeyNotFoundError(k)
+	}
+
+	c.remove(element, Removed)
+
+	return nil
+}
+
+// Len returns the number of cached entries.
+func (c *LFU[K, V]) Len() int { return len(c.entries) }
+
+// Stats returns the cache statistics.
+func (c *LFU[K, V]) Stats() Stats { return c.stats }
+
+// ToOrdered returns the cached entries from the least to the most frequently used.
+func (c *LFU[K, V]) ToOrdered() ordered.Collection[K, V] {
+	collection := ordered.CollectMap(make(map[K]V, len(c.entries)))
+
+	for bucket := c.buckets.Front(); bucket != nil; bucket = bucket.Next() {
+		for element := bucket.Value.(*frequencyBucket).entries.Front(); element != nil; element = element.Next() {
+			e := element.Value.(*lfuEntry[K, V])
+			collection.Put(e.key, e.value)
+		}
+	}
+
+	return collection
+}
+
+// touch moves the entry to the bucket of the next frequency, making it when missing.
+func (c *LFU[K, V]) touch(element *list.Element) *lfuEntry[K, V] {
+	e := element.Value.(*lfuEntry[K, V])
+	current := e.bucket
+	frequency := current.Value.(*frequencyBucket).frequency + 1
+
+	next := current.Next()
+	if next == nil || next.Value.(*frequencyBucket).frequency != frequency {
+		next = c.buckets.InsertAfter(&frequencyBucket{frequency: frequency, entries: list.New()}, current)
+	}
+
+	c.detach(element)
+	e.bucket = next
+	c.entries[e.key] = next.Value.(*frequencyBucket).entries.PushBack(e)
+
+	return e
+}
+
+func (c *LFU[K, V]) remove(element *list.Element, reason EvictionReason) {
+	e := element.Value.(*lfuEntry[K, V])
+
+	c.detach(element)
+	delete(c.entries, e.key)
+	c.options.evict(&c.stats, e.key, e.value, reason)
+}
+
+// detach removes the element from its bucket, dropping the bucket once empty.
+func (c *LFU[K, V]) detach(element *list.Element) {
+	bucket := element.Value.(*lfuEntry[K, V]).bucket
+	entries := bucket.Value.(*frequencyBucket).entries
+	entries.Remove(element)
+
+	if entries.Len() == 0 {
+		c.buckets.Remove(bucket)
+	}
+}
@@ -0,0 +1,130 @@
+package cache
+
+import (
+	"container/list"
+
+	"github.com/thefuga/go-collections/errors"
+	"github.com/thefuga/go-collections/kv/ordered"
+)
+
+type entry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires int64
+}
+
+// LRU is a cache evicting the least recently used entry once full.
+type LRU[K comparable, V any] struct {
+	capacity int
+	recency  *list.List
+	entries  map[K]*list.Element
+	stats    Stats
+	options  options[K, V]
+}
+
+// NewLRU makes an empty LRU cache holding up to capacity entries. Should capacity be
+// 0 or lower, the cache is unbounded.
+func NewLRU[K comparable, V any](capacity int, opts ...Option[K, V]) *LRU[K, V] {
+	return &LRU[K, V]{
+		capacity: capacity,
+		recency:  list.New(),
+		entries:  make(map[K]*list.Element),
+		options:  newOptions(opts),
+	}
+}
+
+// Get calls GetE, omitting the error.
+func (c *LRU[K, V]) Get(k K) V {
+	v, _ := c.GetE(k)
+	return v
+}
+
+// GetE returns the value cached for k, marking it as the most recently used.
+func (c *LRU[K, V]) GetE(k K) (V, error) {
+	element, ok := c.entries[k]
+	if !ok {
+		c.stats.Misses++
+		return *new(V), errors.NewKeyNotFoundError(k)
+	}
+
+	c.stats.Hits++
+	c.recency.MoveToBack(element)
+
+	return element.Value.(*entry[K, V]).value, nil
+}
+
+// Peek calls PeekE, omitting the error.
+func (c *LRU[K, V]) Peek(k K) V {
+	v, _ := c.PeekE(k)
+	return v
+}
+
+// PeekE returns the value cached for k without marking it as used.
+func (c *LRU[K, V]) PeekE(k K) (V, error) {
+	element, ok := c.entries[k]
+	if !ok {
+		return *new(V), errors.NewKeyNotFoundError(k)
+	}
+
+	return element.Value.(*entry[K, V]).value, nil
+}
+
+// Put caches v for k as the most recently used entry. Should the cache be full, the
+// least recently used entry is evicted.
+func (c *LRU[K, V]) Put(k K, v V) {
+	if element, ok := c.entries[k]; ok {
+		element.Value.(*entry[K, V]).value = v
+		c.recency.MoveToBack(element)
+
+		return
+	}
+
+	if c.capacity > 0 && c.recency.Len() >= c.capacity {
+		c.remove(c.recency.Front(), Capacity)
+	}
+
+	c.entries[k] = c.recency.PushBack(&entry[K, V]{key: k, value: v})
+}
+
+// Forget calls ForgetE, omitting the error.
+func (c *LRU[K, V]) Forget(k K) { _ = c.ForgetE(k) }
+
+// ForgetE removes k from the cache.
+func (c *LRU[K, V]) ForgetE(k K) error {
+	element, ok := c.entries[k]
+	if !ok {
+		return errors.NewKeyNotFoundError(k)
+	}
+
+	c.remove(element, Removed)
+
+	return nil
+}
+
+// Len returns the number of cached entries.
+func (c *LRU[K, V]) Len() int { return c.recency.Len() }
+
+// Stats returns the cache statistics.
+func (c *LRU[K, V]) Stats() Stats { return c.stats }
+
+// ToOrdered returns the cached entries from the least to the most recently used.
+func (c *LRU[K, V]) ToOrdered() ordered.Collection[K, V] {
+	return toOrdered[K, V](c.recency)
+}
+
+func (c *LRU[K, V]) remove(element *list.Element, reason EvictionReason) {
+	e := c.recency.Remove(element).(*entry[K, V])
+	delete(c.entries, e.key)
+	c.options.evict(&c.stats, e.key, e.value, reason)
+}
+
+func toOrdered[K comparable, V any](l *list.List) ordered.Collection[K, V] {
+	c := ordered.CollectMap(make(map[K]V, l.Len()))
+
+	for element := l.Front(); element != nil; element = element.Next() {
+		e := element.Value.(*entry[K, V])
+		c.Put(e.key, e.value)
+	}
+
+	return c
+}
@@ -0,0 +1,136 @@
+package cache
+
+import (
+	"sync"
+
+	"github.com/thefuga/go-collections/kv/ordered"
+)
+
+type synchronized[K comparable, V any] struct {
+	mu      sync.Mutex
+	cache   Cache[K, V]
+	onEvict func(k K, v V, reason EvictionReason)
+	pending []pendingEviction[K, V]
+}
+
+type pendingEviction[K comparable, V any] struct {
+	key    K
+	value  V
+	reason EvictionReason
+}
+
+// Synchronized makes a cache with newCache, wrapping it to be safe for concurrent use.
+// Every method holds an exclusive lock, as even lookups update the eviction order of
+// caches. newCache must pass the options it is given to the cache it makes. The eviction
+// callback set by opts is called once the lock is released, so it may use the
+// synchronized cache itself:
+//
+//	c := cache.Synchronized(func(opts ...cache.Option[string, int]) cache.Cache[string, int] {
+//		return cache.NewLRU(100, opts...)
+//	}, cache.WithOnEvict(onEvict))
+//
+// The cache made by newCache is only reachable through the wrapper.
+func Synchronized[K comparable, V any](
+	newCache func(opts ...Option[K, V]) Cache[K, V], opts ...Option[K, V],
+) Cache[K, V] {
+	s := &synchronized[K, V]{onEvict: newOptions(opts).onEvict}
+
+	wrapped := make([]Option[K, V], len(opts), len(opts)+1)
+	copy(wrapped, opts)
+
+	if s.onEvict != nil {
+		wrapped = append(wrapped, WithOnEvict(s.queue))
+	}
+
+	s.cache = newCache(wrapped...)
+
+	return s
+}
+
+func (s *synchronized[K, V]) Get(k K) V {
+	s.mu.Lock()
+	defer s.unlock()
+
+	return s.cache.Get(k)
+}
+
+func (s *synchronized[K, V]) GetE(k K) (V, error) {
+	s.mu.Lock()
+	defer s.unlock()
+
+	return s.cache.GetE(k)
+}
+
+func (s *synchronized[K, V]) Peek(k K) V {
+	s.mu.Lock()
+	defer s.unlock()
+
+	return s.cache.Peek(k)
+}
+
+func (s *synchronized[K, V]) PeekE(k K) (V, error) {
+	s.mu.Lock()
+	defer s.unlock()
+
+	return s.cache.PeekE(k)
+}
+
+func (s *synchronized[K, V]) Put(k K, v V) {
+	s.mu.Lock()
+	defer s.unlock()
+
+	s.cache.Put(k, v)
+}
+
+func (s *synchronized[K, V]) Forget(k K) {
+	s.mu.Lock()
+	defer s.unlock()
+
+	s.cache.Forget(k)
+}
+
+func (s *synchronized[K, V]) ForgetE(k K) error {
+	s.mu.Lock()
+	defer s.unlock()
+
+	return s.cache.ForgetE(k)
+}
+
+func (s *synchronized[K, V]) Len() int {
+	s.mu.Lock()
+	defer s.unlock()
+
+	return s.cache.Len()
+}
+
+func (s *synchronized[K, V]) Stats() Stats {
+	s.mu.Lock()
+	defer s.unlock()
+
+	return s.cache.Stats()
+}
+
+func (s *synchronized[K, V]) ToOrdered() ordered.Collection[K, V] {
+	s.mu.Lock()
+	defer s.unlock()
+
+	return s.cache.ToOrdered()
+}
+
+// queue holds an eviction until the lock is released. It is only called by the wrapped
+// cache, while the lock is held.
+func (s *synchronized[K, V]) queue(k K, v V, reason EvictionReason) {
+	s.pending = append(s.pending, pendingEviction[K, V]{k, v, reason})
+}
+
+// unlock releases the lock, calling the eviction callback with the evictions queued
+// while it was held.
+func (s *synchronized[K, V]) unlock() {
+	evictions := s.pending
+	s.pending = nil
+	s.mu.Unlock()
+
+	for _, e := range evictions {
+		s.onEvict(e.key, e.value, e.reason)
+	}
+}
@@ -0,0 +1,161 @@
+package cache
+
+import (
+	"container/list"
+	"time"
+
+	"github.com/thefuga/go-collections/errors"
+	"github.com/thefuga/go-collections/kv/ordered"
+)
+
+// TTL is a cache expiring entries once they outlive its time to live. Putting an entry
+// resets its time to live. Should the cache be full, the entry closest to expiring is
+// evicted. Expired entries are evicted lazily, when looked up or when putting entries.
+type TTL[K comparable, V any] struct {
+	capacity int
+	ttl      time.Duration
+	expiry   *list.List
+	entries  map[K]*list.Element
+	stats    Stats
+	options  options[K, V]
+}
+
+// NewTTL makes an empty TTL cache holding up to capacity entries for ttl each. Should
+// capacity be 0 or lower, the cache is unbounded.
+func NewTTL[K comparable, V any](capacity int, ttl time.Duration, opts ...Option[K, V]) *TTL[K, V] {
+	return &TTL[K, V]{
+		capacity: capacity,
+		ttl:      ttl,
+		expiry:   list.New(),
+		entries:  make(map[K]*list.Element),
+		options:  newOptions(opts),
+	}
+}
+
+// Get calls GetE, omitting the error.
+func (c *TTL[K, V]) Get(k K) V {
+	v, _ := c.GetE(k)
+	return v
+}
+
+// GetE returns the value cached for k. Expired entries are evicted and reported as misses.
+func (c *TTL[K, V]) GetE(k K) (V, error) {
+	element, ok := c.entries[k]
+
+	if ok && c.expired(element.Value.(*entry[K, V])) {
+		c.remove(element, Expired)
+		ok = false
+	}
+
+	if !ok {
+		c.stats.Misses++
+		return *new(V), errors.NewKeyNotFoundError(k)
+	}
+
+	c.stats.Hits++
+
+	return element.Value.(*entry[K, V]).value, nil
+}
+
+// Peek calls PeekE, omitting the error.
+func (c *TTL[K, V]) Peek(k K) V {
+	v, _ := c.PeekE(k)
+	return v
+}
+
+// PeekE returns the value cached for k without affecting the stats. Expired entries are
+// reported as not found, but are left to be evicted by the other methods.
+func (c *TTL[K, V]) PeekE(k K) (V, error) {
+	element, ok := c.entries[k]
+	if !ok || c.expired(element.Value.(*entry[K, V])) {
+		return *new(V), errors.NewKeyNotFoundError(k)
+	}
+
+	return element.Value.(*entry[K, V]).value, nil
+}
+
+// Put caches v for k, evicting expired entries first.
+func (c *TTL[K, V]) Put(k K, v V) {
+	c.evictExpired()
+
+	e := &entry[K, V]{key: k, value: v, expires: c.options.clock.Now().Add(c.ttl).UnixNano()}
+
+	if element, ok := c.entries[k]; ok {
+		element.Value = e
+		c.expiry.MoveToBack(element)
+
+		return
+	}
+
+	if c.capacity > 0 && c.expiry.Len() >= c.capacity {
+		c.remove(c.expiry.Front(), Capacity)
+	}
+
+	c.entries[k] = c.expiry.PushBack(e)
+}
+
+// Forget calls ForgetE, omitting the error.
+func (c *TTL[K, V]) Forget(k K) { _ = c.ForgetE(k) }
+
+// ForgetE removes k from the cache.
+func (c *TTL[K, V]) ForgetE(k K) error {
+	element, ok := c.entries[k]
+	if !ok {
+		return errors.NewKeyNotFoundError(k)
+	}
+
+	c.remove(element, Removed)
+
+	return nil
+}
+
+// Len returns the number of cached entries, evicting expired entries first.
+func (c *TTL[K, V]) Len() int {
+	c.evictExpired()
+	return c.expiry.Len()
+}
+
+// Stats returns the cache statistics.
+func (c *TTL[K, V]) Stats() Stats { return c.stats }
+
+// ToOrdered returns the entries not expired, from the closest to expiring to the farthest.
+func (c *TTL[K, V]) ToOrdered() ordered.Collection[K, V] {
+	c.evictExpired()
+	return toOrdered[K, V](c.expiry)
+}
+
+// live returns the entries not expired, the same way ToOrdered does, without evicting
+// the expired ones.
+func (c *TTL[K, V]) live() ordered.Collection[K, V] {
+	collection := ordered.CollectMap(make(map[K]V, c.expiry.Len()))
+
+	for element := c.expiry.Front(); element != nil; element = element.Next() {
+		if e := element.Value.(*entry[K, V]); !c.expired(e) {
+			collection.Put(e.key, e.value)
+		}
+	}
+
+	return collection
+}
+
+// evictExpired evicts entries from the front of the list, which expire first as every
+// entry shares the same time to live.
+func (c *TTL[K, V]) evictExpired() {
+	for element := c.expiry.Front(); element != nil; element = c.expiry.Front() {
+		if !c.expired(element.Value.(*entry[K, V])) {
+			return
+		}
+
+		c.remove(element, Expired)
+	}
+}
+
+func (c *TTL[K, V]) expired(e *entry[K, V]) bool {
+	return c.options.clock.Now().UnixNano() >= e.expires
+}
+
+func (c *TTL[K, V]) remove(element *list.Element, reason EvictionReason) {
+	e := c.expiry.Remove(element).(*entry[K, V])
+	delete(c.entries, e.key)
+	c.options.evict(&c.stats, e.key, e.value, reason)
+}
@@ -0,0 +1,94 @@
+package collections
+
+import (
+	"context"
+	"sync"
+
+	"github.com/thefuga/go-collections/internal"
+)
+
+// FanOut distributes the items received from in into n channels by the return value of
+// `f`, forwarding each item as soon as it is received. Items with the same key are sent
+// to the same channel, preserving their order. The channels are unbuffered, so they must
+// be consumed concurrently, and are closed once in is closed or ctx is done. Should n
+// be lower than 1, nil is returned.
+func FanOut[V any, T comparable](ctx context.Context, in <-chan V, n int, f func(v V) T) []<-chan V {
+	if n < 1 {
+		return nil
+	}
+
+	channels := make([]chan V, n)
+	outputs := make([]<-chan V, n)
+
+	for i := range channels {
+		channels[i] = make(chan V)
+		outputs[i] = channels[i]
+	}
+
+	go func() {
+		defer func() {
+			for _, ch := range channels {
+				close(ch)
+			}
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+
+				select {
+				case channels[internal.Hash(f(v))%uint64(n)] <- v:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return outputs
+}
+
+// FanIn merges the given channels into a single one, preserving the order of the values
+// from each channel. The returned channel is closed once every channel is closed or ctx
+// is done.
+func FanIn[V any](ctx context.Context, channels ...<-chan V) <-chan V {
+	out := make(chan V)
+
+	var wg sync.WaitGroup
+	wg.Add(len(channels))
+
+	for _, ch := range channels {
+		go func(ch <-chan V) {
+			defer wg.Done()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case v, ok := <-ch:
+					if !ok {
+						return
+					}
+
+					select {
+					case out <- v:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}(ch)
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
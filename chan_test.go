@@ -0,0 +1,145 @@
+package collections
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestFanOut(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	parity := func(v int) bool { return v%2 == 0 }
+
+	in := make(chan int)
+	go func() {
+		defer close(in)
+
+		for _, v := range values {
+			in <- v
+		}
+	}()
+
+	channels := FanOut(context.Background(), in, 3, parity)
+	if len(channels) != 3 {
+		t.Fatalf("expected 3 channels. got %d", len(channels))
+	}
+
+	received := make([][]int, len(channels))
+
+	var wg sync.WaitGroup
+	wg.Add(len(channels))
+
+	for i, ch := range channels {
+		go func(i int, ch <-chan int) {
+			defer wg.Done()
+
+			for v := range ch {
+				received[i] = append(received[i], v)
+			}
+		}(i, ch)
+	}
+
+	wg.Wait()
+
+	var all []int
+
+	for _, r := range received {
+		for key, group := range GroupBy(r, parity) {
+			if expected := GroupBy(values, parity)[key]; !reflect.DeepEqual(group, expected) {
+				t.Errorf("expected %v. got %v", expected, group)
+			}
+		}
+
+		all = append(all, r...)
+	}
+
+	sort.Ints(all)
+	if !reflect.DeepEqual(all, values) {
+		t.Errorf("expected %v. got %v", values, all)
+	}
+
+	if channels := FanOut(context.Background(), in, 0, parity); channels != nil {
+		t.Errorf("expected nil. got %v", channels)
+	}
+}
+
+func TestFanOutStreams(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	in := make(chan int)
+	go func() {
+		for i := 0; ; i++ {
+			select {
+			case in <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	channels := FanOut(ctx, in, 1, func(v int) int { return v })
+
+	for i := 0; i < 3; i++ {
+		if v := <-channels[0]; v != i {
+			t.Errorf("expected %d. got %d", i, v)
+		}
+	}
+
+	cancel()
+
+	for v := range channels[0] {
+		if v < 3 {
+			t.Errorf("expected in-flight values to follow 2. got %d", v)
+		}
+	}
+}
+
+func TestFanIn(t *testing.T) {
+	first, second := make(chan int), make(chan int)
+
+	go func() {
+		for i := 0; i < 5; i++ {
+			first <- i
+		}
+		close(first)
+	}()
+
+	go func() {
+		for i := 10; i < 15; i++ {
+			second <- i
+		}
+		close(second)
+	}()
+
+	var fromFirst, fromSecond []int
+
+	for v := range FanIn(context.Background(), first, second) {
+		if v < 10 {
+			fromFirst = append(fromFirst, v)
+		} else {
+			fromSecond = append(fromSecond, v)
+		}
+	}
+
+	if expected := []int{0, 1, 2, 3, 4}; !reflect.DeepEqual(fromFirst, expected) {
+		t.Errorf("expected %v. got %v", expected, fromFirst)
+	}
+
+	if expected := []int{10, 11, 12, 13, 14}; !reflect.DeepEqual(fromSecond, expected) {
+		t.Errorf("expected %v. got %v", expected, fromSecond)
+	}
+}
+
+func TestFanInCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	never := make(chan int)
+
+	out := FanIn(ctx, never)
+	cancel()
+
+	if _, ok := <-out; ok {
+		t.Error("expected the channel to be closed")
+	}
+}
@@ -0,0 +1,112 @@
+package collections
+
+import (
+	"context"
+	stdErrors "errors"
+
+	"github.com/thefuga/go-collections/errors"
+)
+
+// EachCtx acts just like EachE, checking ctx before each item. Should ctx be done, an
+// instance of errors.InterruptedError wrapping ctx.Err() and holding the number of
+// processed items is returned.
+func EachCtx[T any](ctx context.Context, f func(i int, v T) error, slice []T) error {
+	for i, v := range slice {
+		if err := ctx.Err(); err != nil {
+			return errors.NewInterruptedError(i, err)
+		}
+
+		if err := f(i, v); err != nil {
+			if stdErrors.Is(err, errors.Stop) {
+				return nil
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MapCtx acts just like Map, but f may fail and ctx is checked before each item.
+// Should f return an error or ctx be done, an empty slice and the error are returned,
+// the same way EachCtx does. Should f return errors.Stop, the values mapped before it
+// are returned.
+func MapCtx[T any, R any](ctx context.Context, slice []T, f func(i int, v T) (R, error)) ([]R, error) {
+	mappedValues := make([]R, 0, len(slice))
+
+	err := EachCtx(ctx, func(i int, v T) error {
+		mapped, err := f(i, v)
+		if err == nil {
+			mappedValues = Push(mappedValues, mapped)
+		}
+
+		return err
+	}, slice)
+
+	if err != nil {
+		return []R{}, err
+	}
+
+	return mappedValues, nil
+}
+
+// FilterCtx makes a new slice holding the items matched by f, checking ctx before each
+// item. Should f return an error or ctx be done, an empty slice and the error are
+// returned, the same way EachCtx does. Should f return errors.Stop, the items matched
+// before it are returned.
+func FilterCtx[T any](ctx context.Context, slice []T, f func(i int, v T) (bool, error)) ([]T, error) {
+	var filtered []T
+
+	err := EachCtx(ctx, func(i int, v T) error {
+		matched, err := f(i, v)
+		if matched && err == nil {
+			filtered = Push(filtered, v)
+		}
+
+		return err
+	}, slice)
+
+	if err != nil {
+		return []T{}, err
+	}
+
+	return filtered, nil
+}
+
+// ReduceCtx acts just like Reduce, but f may fail and ctx is checked before each item.
+// Should f return an error or ctx be done, the carry computed so far and the error are
+// returned, the same way EachCtx does.
+func ReduceCtx[T, V any](
+	ctx context.Context, slice []T, f func(carry V, v T, i int) (V, error), carry V,
+) (V, error) {
+	err := EachCtx(ctx, func(i int, v T) error {
+		reduced, err := f(carry, v, i)
+		if err == nil {
+			carry = reduced
+		}
+
+		return err
+	}, slice)
+
+	return carry, err
+}
+
+// GroupByCtx acts just like GroupBy, checking ctx before each item. Should ctx be done,
+// nil and the error are returned, the same way EachCtx does.
+func GroupByCtx[V any, T comparable](ctx context.Context, slice []V, f func(v V) T) (map[T][]V, error) {
+	groups := make(map[T][]V)
+
+	err := EachCtx(ctx, func(_ int, v V) error {
+		key := f(v)
+		groups[key] = append(groups[key], v)
+
+		return nil
+	}, slice)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
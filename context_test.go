@@ -0,0 +1,150 @@
+package collections
+
+import (
+	"context"
+	stdErrors "errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/thefuga/go-collections/errors"
+)
+
+func TestEachCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var visited []int
+
+	err := EachCtx(ctx, func(i int, v int) error {
+		visited = append(visited, v)
+
+		if i == 1 {
+			cancel()
+		}
+
+		return nil
+	}, []int{1, 2, 3, 4})
+
+	if !stdErrors.Is(err, context.Canceled) {
+		t.Errorf("expected %v. got %v", context.Canceled, err)
+	}
+
+	if expected := "context canceled: interrupted after processing 2 items"; err == nil || err.Error() != expected {
+		t.Errorf("expected %q. got %v", expected, err)
+	}
+
+	if expected := []int{1, 2}; !reflect.DeepEqual(visited, expected) {
+		t.Errorf("expected %v. got %v", expected, visited)
+	}
+}
+
+func TestMapCtx(t *testing.T) {
+	failure := fmt.Errorf("failure")
+
+	testCases := []struct {
+		description string
+		f           func(i int, v int) (string, error)
+		expected    []string
+		err         error
+	}{
+		{
+			"mapping every value",
+			func(_ int, v int) (string, error) { return fmt.Sprint(v), nil },
+			[]string{"1", "2", "3"},
+			nil,
+		},
+		{
+			"stopping early",
+			func(i int, v int) (string, error) {
+				if i == 2 {
+					return "", errors.Stop
+				}
+				return fmt.Sprint(v), nil
+			},
+			[]string{"1", "2"},
+			nil,
+		},
+		{
+			"failing",
+			func(int, int) (string, error) { return "", failure },
+			[]string{},
+			failure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			mapped, err := MapCtx(context.Background(), []int{1, 2, 3}, tc.f)
+
+			if err != tc.err {
+				t.Errorf("expected error %v. got %v", tc.err, err)
+			}
+
+			if !reflect.DeepEqual(mapped, tc.expected) {
+				t.Errorf("expected %v. got %v", tc.expected, mapped)
+			}
+		})
+	}
+}
+
+func TestFilterCtx(t *testing.T) {
+	even, err := FilterCtx(context.Background(), []int{1, 2, 3, 4}, func(_ int, v int) (bool, error) {
+		return v%2 == 0, nil
+	})
+
+	if err != nil || !reflect.DeepEqual(even, []int{2, 4}) {
+		t.Errorf("expected [2 4]. got %v, %v", even, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if filtered, err := FilterCtx(ctx, []int{1}, func(int, int) (bool, error) { return true, nil }); filtered == nil ||
+		len(filtered) != 0 || !stdErrors.Is(err, context.Canceled) {
+		t.Errorf("expected an empty slice and %v. got %v, %v", context.Canceled, filtered, err)
+	}
+
+	if mapped, err := MapCtx(ctx, []int{1}, func(_ int, v int) (int, error) { return v, nil }); mapped == nil ||
+		len(mapped) != 0 || !stdErrors.Is(err, context.Canceled) {
+		t.Errorf("expected an empty slice and %v. got %v, %v", context.Canceled, mapped, err)
+	}
+}
+
+func TestReduceCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sum, err := ReduceCtx(ctx, []int{1, 2, 3, 4}, func(carry int, v int, i int) (int, error) {
+		if i == 2 {
+			cancel()
+		}
+		return carry + v, nil
+	}, 0)
+
+	if !stdErrors.Is(err, context.Canceled) {
+		t.Errorf("expected %v. got %v", context.Canceled, err)
+	}
+
+	if sum != 6 {
+		t.Errorf("expected the partial sum 6. got %d", sum)
+	}
+}
+
+func TestGroupByCtx(t *testing.T) {
+	groups, err := GroupByCtx(context.Background(), []int{1, 2, 3}, func(v int) bool { return v%2 == 0 })
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if expected := map[bool][]int{false: {1, 3}, true: {2}}; !reflect.DeepEqual(groups, expected) {
+		t.Errorf("expected %v. got %v", expected, groups)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+
+	if _, err := GroupByCtx(ctx, []int{1}, func(v int) int { return v }); !stdErrors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected %v. got %v", context.DeadlineExceeded, err)
+	}
+}
@@ -0,0 +1,125 @@
+package csv
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/thefuga/go-collections/internal"
+)
+
+const tag = "csv"
+
+var (
+	textMarshaler   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshaler = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// ParseError is returned when a CSV value can't be converted to its field type. Row
+// and Column are 1-based positions on the file, counting the header as the first row.
+type ParseError struct {
+	Row    int
+	Column int
+	Field  string
+	Err    error
+}
+
+func (e ParseError) Error() string {
+	return fmt.Sprintf("csv: row %d, column %d (%s): %v", e.Row, e.Column, e.Field, e.Err)
+}
+
+func (e ParseError) Unwrap() error { return e.Err }
+
+// fieldsOf returns the CSV fields of T, which must be a struct.
+func fieldsOf[T any]() ([]internal.Field, error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("csv: %v is not a struct", t)
+	}
+
+	return internal.Fields(t, tag), nil
+}
+
+func format(v reflect.Value) (string, error) {
+	if v.Type().Implements(textMarshaler) {
+		if v.Kind() == reflect.Pointer && v.IsNil() {
+			return "", nil
+		}
+
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), err
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+	case reflect.Pointer:
+		if v.IsNil() {
+			return "", nil
+		}
+
+		return format(v.Elem())
+	}
+
+	return "", fmt.Errorf("unsupported type %v", v.Type())
+}
+
+func parse(s string, v reflect.Value) error {
+	if v.CanAddr() && v.Addr().Type().Implements(textUnmarshaler) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Pointer:
+		if s == "" {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+
+		elem := reflect.New(v.Type().Elem())
+		if err := parse(s, elem.Elem()); err != nil {
+			return err
+		}
+		v.Set(elem)
+	default:
+		return fmt.Errorf("unsupported type %v", v.Type())
+	}
+
+	return nil
+}
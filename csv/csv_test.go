@@ -0,0 +1,185 @@
+package csv
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thefuga/go-collections/kv/ordered"
+	"github.com/thefuga/go-collections/slice"
+)
+
+type user struct {
+	ID       int       `csv:"id"`
+	Name     string    `csv:"name"`
+	Score    float64   `csv:"score"`
+	Admin    bool      `csv:"admin"`
+	Joined   time.Time `csv:"joined"`
+	Manager  *int      `csv:"manager"`
+	Password string    `csv:"-"`
+	Nickname string
+	internal string
+}
+
+func managerID(id int) *int { return &id }
+
+var users = slice.Collect(
+	user{ID: 1, Name: "Alice", Score: 9.5, Admin: true, Joined: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), Nickname: "al"},
+	user{ID: 2, Name: "Bob, Jr.", Score: 7, Joined: time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC), Manager: managerID(1)},
+)
+
+const usersCSV = `id,name,score,admin,joined,manager,Nickname
+1,Alice,9.5,true,2020-01-02T00:00:00Z,,al
+2,"Bob, Jr.",7,false,2021-03-04T00:00:00Z,1,
+`
+
+func TestWrite(t *testing.T) {
+	var buffer bytes.Buffer
+
+	if err := Write(&buffer, users); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if buffer.String() != usersCSV {
+		t.Errorf("expected\n%s\ngot\n%s", usersCSV, buffer.String())
+	}
+}
+
+func TestWriteEmpty(t *testing.T) {
+	var buffer bytes.Buffer
+
+	if err := Write[user](&buffer, nil); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if expected := "id,name,score,admin,joined,manager,Nickname\n"; buffer.String() != expected {
+		t.Errorf("expected only the header to be written. got %s", buffer.String())
+	}
+}
+
+func TestRead(t *testing.T) {
+	read, err := Read[user](strings.NewReader(usersCSV))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(read, users) {
+		t.Errorf("expected %v. got %v", users, read)
+	}
+}
+
+func TestReadColumnsInAnyOrder(t *testing.T) {
+	read, err := Read[user](strings.NewReader("unknown,name,id\nx,Alice,1\n"))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if expected := slice.Collect(user{ID: 1, Name: "Alice"}); !reflect.DeepEqual(read, expected) {
+		t.Errorf("expected %v. got %v", expected, read)
+	}
+}
+
+func TestReadParseError(t *testing.T) {
+	_, err := Read[user](strings.NewReader("id,name,score\n1,Alice,9\n2,Bob,high\n"))
+
+	var parseErr ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("expected a parse error. got %v", err)
+	}
+
+	if parseErr.Row != 3 || parseErr.Column != 3 || parseErr.Field != "score" {
+		t.Errorf("unexpected error position %+v", parseErr)
+	}
+
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("expected the conversion error to be wrapped. got %v", err)
+	}
+
+	if expected := `csv: row 3, column 3 (score): strconv.ParseFloat: parsing "high": invalid syntax`; err.Error() != expected {
+		t.Errorf("expected error to be %s. got %s", expected, err.Error())
+	}
+}
+
+func TestReadOrdered(t *testing.T) {
+	read, err := ReadOrdered[string, user](strings.NewReader(usersCSV), "name")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if expected := slice.Collect("Alice", "Bob, Jr."); !reflect.DeepEqual(read.Keys(), expected) {
+		t.Errorf("expected %v. got %v", expected, read.Keys())
+	}
+
+	if _, err := ReadOrdered[string, user](strings.NewReader(usersCSV), "id"); err == nil {
+		t.Error("expected an error when the column type doesn't match the key type")
+	}
+	if read, err := ReadOrdered[string, user](strings.NewReader("id,score\n1,2\n2,3\n"), "name"); err == nil ||
+		err.Error() != "key 'name' not found" || !read.IsEmpty() {
+		t.Errorf("expected a key not found error when the header lacks the column. got %v, %v", read, err)
+	}
+
+	if read, err := ReadOrdered[string, user](strings.NewReader(""), "name"); err != nil || !read.IsEmpty() {
+		t.Errorf("expected an empty collection reading an empty file. got %v, %v", read, err)
+	}
+}
+
+func TestWriteOrdered(t *testing.T) {
+	c := ordered.CollectMap(map[string]user{})
+	c.Put("Bob, Jr.", users[1])
+	c.Put("Alice", users[0])
+
+	var buffer bytes.Buffer
+	if err := WriteOrdered(&buffer, c); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	read, err := ReadOrdered[string, user](&buffer, "name")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if expected := slice.Collect("Bob, Jr.", "Alice"); !reflect.DeepEqual(read.Keys(), expected) {
+		t.Errorf("expected %v. got %v", expected, read.Keys())
+	}
+}
+
+func TestStreaming(t *testing.T) {
+	var buffer bytes.Buffer
+
+	writer, err := NewWriter[user](&buffer)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	for i := 0; i < 1000; i++ {
+		if err := writer.Write(user{ID: i}); err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+	}
+
+	if err := writer.Flush(); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	reader, _ := NewReader[user](&buffer)
+	sum := 0
+
+	err = reader.Each(func(u user) error {
+		sum += u.ID
+		return nil
+	})
+
+	if err != nil || sum != 999*1000/2 {
+		t.Errorf("unexpected sum %d (%v)", sum, err)
+	}
+}
+
+func TestNonStruct(t *testing.T) {
+	if _, err := NewWriter[int](&bytes.Buffer{}); err == nil || err.Error() != "csv: int is not a struct" {
+		t.Errorf("expected an error. got %v", err)
+	}
+}
@@ -0,0 +1,28 @@
+package csv
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+type product struct {
+	SKU   string  `csv:"sku"`
+	Price float64 `csv:"price"`
+}
+
+func ExampleWrite() {
+	_ = Write(os.Stdout, []product{{"A1", 9.99}, {"B2", 15}})
+	// Output:
+	// sku,price
+	// A1,9.99
+	// B2,15
+}
+
+func ExampleReadOrdered() {
+	products, _ := ReadOrdered[string, product](strings.NewReader("sku,price\nA1,9.99\nB2,15\n"), "sku")
+
+	fmt.Println(products.Get("B2").Price)
+	// Output:
+	// 15
+}
@@ -0,0 +1,187 @@
+package csv
+
+import (
+	stdcsv "encoding/csv"
+	stdErrors "errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/thefuga/go-collections/errors"
+	"github.com/thefuga/go-collections/internal"
+	"github.com/thefuga/go-collections/kv/ordered"
+	"github.com/thefuga/go-collections/slice"
+)
+
+// Reader streams structs from a CSV file, one row at a time. Columns are matched to
+// fields by the header, so they may come in any order. Columns without a matching
+// field are ignored and fields without a matching column are left zeroed.
+type Reader[T any] struct {
+	csv     *stdcsv.Reader
+	fields  []internal.Field
+	columns []int
+	row     int
+}
+
+// NewReader makes a Reader reading from r. T must be a struct.
+func NewReader[T any](r io.Reader) (*Reader[T], error) {
+	fields, err := fieldsOf[T]()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reader[T]{csv: stdcsv.NewReader(r), fields: fields}, nil
+}
+
+// Read reads the next row. At the end of the file, io.EOF is returned. Should a value
+// not be convertible to its field type, a ParseError is returned.
+func (r *Reader[T]) Read() (T, error) {
+	var item T
+
+	if err := r.readHeader(); err != nil {
+		return item, err
+	}
+
+	record, err := r.csv.Read()
+	if err != nil {
+		return item, err
+	}
+
+	r.row++
+	v := reflect.ValueOf(&item).Elem()
+
+	for column, fieldIndex := range r.columns {
+		if fieldIndex < 0 || column >= len(record) {
+			continue
+		}
+
+		field := r.fields[fieldIndex]
+
+		if err := parse(record[column], v.Field(field.Index)); err != nil {
+			return item, ParseError{Row: r.row, Column: column + 1, Field: field.Name, Err: err}
+		}
+	}
+
+	return item, nil
+}
+
+// Each calls f with every remaining row, stopping at the first error, which is
+// returned. Reaching the end of the file is not an error.
+func (r *Reader[T]) Each(f func(item T) error) error {
+	for {
+		item, err := r.Read()
+		if stdErrors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := f(item); err != nil {
+			return err
+		}
+	}
+}
+
+// readHeader maps the columns of the header to the fields of T.
+func (r *Reader[T]) readHeader() error {
+	if r.columns != nil {
+		return nil
+	}
+
+	header, err := r.csv.Read()
+	if err != nil {
+		return err
+	}
+
+	r.row++
+	r.columns = make([]int, len(header))
+
+	for column, name := range header {
+		r.columns[column] = -1
+
+		for i, field := range r.fields {
+			if field.Name == name {
+				r.columns[column] = i
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// Read reads every row of r into a slice collection.
+func Read[T any](r io.Reader) (slice.Collection[T], error) {
+	reader, err := NewReader[T](r)
+	if err != nil {
+		return nil, err
+	}
+
+	items := slice.Collection[T]{}
+
+	err = reader.Each(func(item T) error {
+		items = items.Push(item)
+		return nil
+	})
+
+	return items, err
+}
+
+// ReadOrdered reads every row of r into an ordered collection keyed by the field
+// mapped to column, preserving the order of the rows. Should column not be mapped to
+// a field of type K, an error is returned. Should the header lack column, an instance of
+// errors.KeyNotFoundError is returned. Rows with repeated keys replace the previous
+// ones.
+func ReadOrdered[K comparable, T any](r io.Reader, column string) (ordered.Collection[K, T], error) {
+	items := ordered.CollectMap(map[K]T{})
+
+	reader, err := NewReader[T](r)
+	if err != nil {
+		return items, err
+	}
+
+	key := -1
+	for i, field := range reader.fields {
+		if field.Name == column && field.Type == reflect.TypeOf((*K)(nil)).Elem() {
+			key = i
+		}
+	}
+
+	if key < 0 {
+		return items, fmt.Errorf("csv: no column %s of type %T", column, *new(K))
+	}
+
+	if err := reader.readHeader(); err != nil {
+		if stdErrors.Is(err, io.EOF) {
+			return items, nil
+		}
+
+		return items, err
+	}
+
+	if !reader.hasColumn(key) {
+		return items, errors.NewKeyNotFoundError(column)
+	}
+
+	keyIndex := reader.fields[key].Index
+
+	err = reader.Each(func(item T) error {
+		items.Put(reflect.ValueOf(item).Field(keyIndex).Interface().(K), item)
+		return nil
+	})
+
+	return items, err
+}
+
+// hasColumn checks if the header maps a column to the field at the index i of fields.
+func (r *Reader[T]) hasColumn(i int) bool {
+	for _, fieldIndex := range r.columns {
+		if fieldIndex == i {
+			return true
+		}
+	}
+
+	return false
+}
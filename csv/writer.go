@@ -0,0 +1,117 @@
+// Package csv encodes and decodes collections of structs as CSV. Struct fields map to
+// columns by their `csv` tag, or by their name when untagged. Fields tagged with "-"
+// and unexported fields are skipped. Strings, booleans, numbers, pointers to them and
+// types implementing encoding.TextMarshaler and encoding.TextUnmarshaler are supported.
+package csv
+
+import (
+	stdcsv "encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/thefuga/go-collections/internal"
+	"github.com/thefuga/go-collections/kv/ordered"
+)
+
+// Writer streams structs to a CSV file, one row at a time. The header is written
+// along with the first row.
+type Writer[T any] struct {
+	csv           *stdcsv.Writer
+	fields        []internal.Field
+	headerWritten bool
+}
+
+// NewWriter makes a Writer writing to w. T must be a struct.
+func NewWriter[T any](w io.Writer) (*Writer[T], error) {
+	fields, err := fieldsOf[T]()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Writer[T]{csv: stdcsv.NewWriter(w), fields: fields}, nil
+}
+
+// Write writes item as a row.
+func (w *Writer[T]) Write(item T) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	v := reflect.ValueOf(item)
+	record := make([]string, len(w.fields))
+
+	for i, field := range w.fields {
+		formatted, err := format(v.Field(field.Index))
+		if err != nil {
+			return fmt.Errorf("csv: column %s: %w", field.Name, err)
+		}
+
+		record[i] = formatted
+	}
+
+	return w.csv.Write(record)
+}
+
+// Flush writes any buffered rows, as well as the header when no rows were written.
+func (w *Writer[T]) Flush() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	w.csv.Flush()
+
+	return w.csv.Error()
+}
+
+func (w *Writer[T]) writeHeader() error {
+	if w.headerWritten {
+		return nil
+	}
+
+	header := make([]string, len(w.fields))
+	for i, field := range w.fields {
+		header[i] = field.Name
+	}
+
+	w.headerWritten = true
+
+	return w.csv.Write(header)
+}
+
+// Write writes a header followed by every item to w. Columns follow the declaration
+// order of the fields of T.
+func Write[T any](w io.Writer, items []T) error {
+	writer, err := NewWriter[T](w)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if err := writer.Write(item); err != nil {
+			return err
+		}
+	}
+
+	return writer.Flush()
+}
+
+// WriteOrdered writes a header followed by every value of items to w, in the collection
+// order, the same way Write does. Keys are not written: key the collection by a field
+// of T to read it back with ReadOrdered.
+func WriteOrdered[K comparable, T any](w io.Writer, items ordered.Collection[K, T]) error {
+	writer, err := NewWriter[T](w)
+	if err != nil {
+		return err
+	}
+
+	err = items.EachE(func(_ K, item T) error {
+		return writer.Write(item)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return writer.Flush()
+}
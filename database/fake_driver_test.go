@@ -0,0 +1,101 @@
+package database
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+)
+
+// fakeDriver is an in-memory driver answering queries with the results registered on
+// its tables. Executing "INSERT x" appends its arguments as a row of "SELECT x".
+type fakeDriver struct {
+	mu     sync.Mutex
+	tables map[string]*fakeTable
+}
+
+type fakeTable struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+var fake = &fakeDriver{tables: map[string]*fakeTable{}}
+
+func init() {
+	sql.Register("fake", fake)
+}
+
+func (d *fakeDriver) register(query string, columns []string, rows ...[]driver.Value) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.tables[query] = &fakeTable{columns: columns, rows: rows}
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) { return fakeConn{d}, nil }
+
+type fakeConn struct{ driver *fakeDriver }
+
+func (c fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return fakeStmt{c.driver, query}, nil
+}
+
+func (fakeConn) Close() error { return nil }
+
+func (fakeConn) Begin() (driver.Tx, error) { return nil, errors.New("transactions not supported") }
+
+type fakeStmt struct {
+	driver *fakeDriver
+	query  string
+}
+
+func (fakeStmt) Close() error  { return nil }
+func (fakeStmt) NumInput() int { return -1 }
+
+func (s fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.driver.mu.Lock()
+	defer s.driver.mu.Unlock()
+
+	table, ok := s.driver.tables[strings.Replace(s.query, "INSERT", "SELECT", 1)]
+	if !ok {
+		return nil, errors.New("unknown table")
+	}
+
+	table.rows = append(table.rows, args)
+
+	return driver.RowsAffected(1), nil
+}
+
+func (s fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	s.driver.mu.Lock()
+	defer s.driver.mu.Unlock()
+
+	table, ok := s.driver.tables[s.query]
+	if !ok {
+		return nil, errors.New("unknown query")
+	}
+
+	return &fakeRows{columns: table.columns, rows: table.rows}, nil
+}
+
+type fakeRows struct {
+	columns []string
+	rows    [][]driver.Value
+	next    int
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.rows) {
+		return io.EOF
+	}
+
+	copy(dest, r.rows[r.next])
+	r.next++
+
+	return nil
+}
@@ -0,0 +1,154 @@
+// Package database collects database/sql query results into collections.
+//
+// Rows are scanned into structs by matching the column names to the `db` tag of the
+// struct fields, or to their names when untagged. Columns without a matching field
+// are discarded. Non struct types are scanned directly, which requires queries to
+// return a single column.
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/thefuga/go-collections/internal"
+	"github.com/thefuga/go-collections/kv"
+	"github.com/thefuga/go-collections/kv/ordered"
+	"github.com/thefuga/go-collections/slice"
+)
+
+const tag = "db"
+
+// Collect scans every row into a slice collection, preserving the query order. Rows
+// are closed once collected. Should a row fail to be collected, an empty collection and
+// the error are returned.
+func Collect[T any](rows *sql.Rows) (slice.Collection[T], error) {
+	items := slice.Collection[T]{}
+
+	err := each(rows, func(item T) error {
+		items = items.Push(item)
+		return nil
+	})
+
+	if err != nil {
+		return slice.Collection[T]{}, err
+	}
+
+	return items, nil
+}
+
+// CollectKV scans every row into a kv collection keyed by the field mapped to column.
+// Rows with repeated keys replace the previous ones. Rows are closed once collected.
+// Errors are returned the same way Collect does.
+func CollectKV[K comparable, T any](rows *sql.Rows, column string) (kv.Collection[K, T], error) {
+	items := kv.Collection[K, T]{}
+
+	err := eachKeyed(rows, column, func(k K, item T) {
+		items.Put(k, item)
+	})
+
+	if err != nil {
+		return kv.Collection[K, T]{}, err
+	}
+
+	return items, nil
+}
+
+// CollectOrdered scans every row into an ordered collection keyed by the field mapped
+// to column, preserving the query order. Rows with repeated keys replace the previous
+// ones. Rows are closed once collected. Errors are returned the same way Collect does.
+func CollectOrdered[K comparable, T any](rows *sql.Rows, column string) (ordered.Collection[K, T], error) {
+	items := ordered.CollectMap(map[K]T{})
+
+	err := eachKeyed(rows, column, func(k K, item T) {
+		items.Put(k, item)
+	})
+
+	if err != nil {
+		return ordered.CollectMap(map[K]T{}), err
+	}
+
+	return items, nil
+}
+
+func eachKeyed[K comparable, T any](rows *sql.Rows, column string, f func(k K, item T)) error {
+	return each(rows, func(item T) error {
+		field, ok := internal.FieldByName(reflect.ValueOf(item), column, tag)
+		if !ok {
+			return fmt.Errorf("database: no field mapped to column %s", column)
+		}
+
+		k, ok := field.Interface().(K)
+		if !ok {
+			return fmt.Errorf("database: column %s is %v, not %T", column, field.Type(), *new(K))
+		}
+
+		f(k, item)
+
+		return nil
+	})
+}
+
+func each[T any](rows *sql.Rows, f func(item T) error) error {
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+
+	for rows.Next() {
+		var item T
+
+		targets, err := scanTargets(&item, columns)
+		if err != nil {
+			return err
+		}
+
+		if err := rows.Scan(targets...); err != nil {
+			return err
+		}
+
+		if err := f(item); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// scanTargets returns the pointers rows must be scanned into to fill item.
+func scanTargets[T any](item *T, columns []string) ([]any, error) {
+	v := reflect.ValueOf(item).Elem()
+
+	if v.Kind() != reflect.Struct || isScanner(v) {
+		if len(columns) != 1 {
+			return nil, fmt.Errorf("database: %d columns can't be scanned into %T", len(columns), *item)
+		}
+
+		return []any{item}, nil
+	}
+
+	targets := make([]any, len(columns))
+
+	for i, column := range columns {
+		if field, ok := internal.FieldByName(v, column, tag); ok {
+			targets[i] = field.Addr().Interface()
+		} else {
+			targets[i] = new(any)
+		}
+	}
+
+	return targets, nil
+}
+
+// isScanner checks if v scans itself, like sql.NullString or time.Time, which are
+// structs scanned as a single column.
+func isScanner(v reflect.Value) bool {
+	if _, ok := v.Addr().Interface().(sql.Scanner); ok {
+		return true
+	}
+
+	return v.Type() == reflect.TypeOf(time.Time{})
+}
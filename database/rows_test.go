@@ -0,0 +1,165 @@
+package database
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/thefuga/go-collections/bitset"
+	"github.com/thefuga/go-collections/kv"
+	"github.com/thefuga/go-collections/slice"
+)
+
+type user struct {
+	ID      int64          `db:"id"`
+	Name    string         `db:"name"`
+	Email   sql.NullString `db:"email"`
+	Created time.Time
+	Secret  string `db:"-"`
+}
+
+var created = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func open(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("fake", "")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	fake.register(
+		"SELECT users",
+		[]string{"id", "name", "email", "Created", "ignored"},
+		[]driver.Value{int64(2), "Bob", nil, created, "x"},
+		[]driver.Value{int64(1), "Alice", "alice@example.com", created, "y"},
+	)
+
+	return db
+}
+
+func query(t *testing.T, db *sql.DB, q string) *sql.Rows {
+	t.Helper()
+
+	rows, err := db.Query(q)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	return rows
+}
+
+var (
+	bob   = user{ID: 2, Name: "Bob", Created: created}
+	alice = user{ID: 1, Name: "Alice", Email: sql.NullString{String: "alice@example.com", Valid: true}, Created: created}
+)
+
+func TestCollect(t *testing.T) {
+	db := open(t)
+
+	users, err := Collect[user](query(t, db, "SELECT users"))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if expected := slice.Collect(bob, alice); !reflect.DeepEqual(users, expected) {
+		t.Errorf("expected %v. got %v", expected, users)
+	}
+
+	if ids, err := Collect[int64](query(t, db, "SELECT users")); err == nil || ids == nil || !ids.IsEmpty() {
+		t.Errorf("expected an empty collection and an error scanning many columns into a non struct type. got %v, %v", ids, err)
+	}
+}
+
+func TestCollectSingleColumn(t *testing.T) {
+	db := open(t)
+	fake.register("SELECT ids", []string{"id"}, []driver.Value{int64(3)}, []driver.Value{int64(4)})
+
+	ids, err := Collect[int64](query(t, db, "SELECT ids"))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if expected := slice.Collect[int64](3, 4); !reflect.DeepEqual(ids, expected) {
+		t.Errorf("expected %v. got %v", expected, ids)
+	}
+}
+
+func TestCollectKV(t *testing.T) {
+	db := open(t)
+
+	users, err := CollectKV[int64, user](query(t, db, "SELECT users"), "id")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if expected := (kv.Collection[int64, user]{1: alice, 2: bob}); !reflect.DeepEqual(users, expected) {
+		t.Errorf("expected %v. got %v", expected, users)
+	}
+
+	if _, err := CollectKV[string, user](query(t, db, "SELECT users"), "id"); err == nil ||
+		err.Error() != "database: column id is int64, not string" {
+		t.Errorf("expected a key type error. got %v", err)
+	}
+
+	if users, err := CollectKV[string, user](query(t, db, "SELECT users"), "missing"); err == nil || users == nil || !users.IsEmpty() {
+		t.Errorf("expected an empty collection and an unknown column error. got %v, %v", users, err)
+	}
+}
+
+func TestCollectOrdered(t *testing.T) {
+	db := open(t)
+
+	users, err := CollectOrdered[string, user](query(t, db, "SELECT users"), "name")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if expected := slice.Collect("Bob", "Alice"); !reflect.DeepEqual(users.Keys(), expected) {
+		t.Errorf("expected %v. got %v", expected, users.Keys())
+	}
+	fake.register(
+		"SELECT broken users",
+		[]string{"id", "name"},
+		[]driver.Value{int64(1), "Alice"},
+		[]driver.Value{"not a number", "Bob"},
+	)
+
+	users, err = CollectOrdered[string, user](query(t, db, "SELECT broken users"), "name")
+	if err == nil || !users.IsEmpty() {
+		t.Errorf("expected an empty collection and a scan error. got %v, %v", users, err)
+	}
+}
+
+func TestValuerAndScanner(t *testing.T) {
+	db := open(t)
+	fake.register("SELECT tags", []string{"tags", "flags"})
+
+	if _, err := db.Exec("INSERT tags", slice.Collect("a", "b"), bitset.New(1, 5)); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if _, err := db.Exec("INSERT tags", slice.Collection[string](nil), bitset.New()); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	type row struct {
+		Tags  slice.Collection[string] `db:"tags"`
+		Flags bitset.BitSet            `db:"flags"`
+	}
+
+	rows, err := Collect[row](query(t, db, "SELECT tags"))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(rows[0].Tags, slice.Collect("a", "b")) || !rows[0].Flags.Equals(bitset.New(1, 5)) {
+		t.Errorf("unexpected first row %v", rows[0])
+	}
+
+	if rows[1].Tags != nil || !rows[1].Flags.IsEmpty() {
+		t.Errorf("unexpected second row %v", rows[1])
+	}
+}
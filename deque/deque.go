@@ -0,0 +1,331 @@
+// Package deque provides a double-ended queue backed by a ring buffer.
+package deque
+
+import (
+	"github.com/thefuga/go-collections"
+	"github.com/thefuga/go-collections/errors"
+	"github.com/thefuga/go-collections/internal"
+	"github.com/thefuga/go-collections/slice"
+)
+
+const defaultCapacity = 8
+
+// GrowthPolicy returns the new capacity of a full deque, given its current capacity.
+// Capacities not greater than the current one are raised to the current one plus one.
+type GrowthPolicy func(capacity int) int
+
+// ShrinkPolicy returns the new capacity of a deque after an element is removed,
+// given its count and capacity. Returning the current capacity leaves the deque as is.
+type ShrinkPolicy func(count, capacity int) int
+
+// Option configures a deque made with New.
+type Option func(*config)
+
+type config struct {
+	capacity int
+	grow     GrowthPolicy
+	shrink   ShrinkPolicy
+}
+
+// WithCapacity sets the initial (and minimum) capacity of the deque.
+func WithCapacity(capacity int) Option {
+	return func(c *config) {
+		if capacity > 0 {
+			c.capacity = capacity
+		}
+	}
+}
+
+// WithGrowth sets the policy used to grow the deque once it is full.
+// Doubling the capacity is the default.
+func WithGrowth(policy GrowthPolicy) Option {
+	return func(c *config) { c.grow = policy }
+}
+
+// WithShrink sets the policy used to shrink the deque after removals.
+// Halving the capacity once the deque is a quarter full is the default.
+func WithShrink(policy ShrinkPolicy) Option {
+	return func(c *config) { c.shrink = policy }
+}
+
+// Double is the default GrowthPolicy.
+func Double(capacity int) int { return capacity * 2 }
+
+// Halve is the default ShrinkPolicy.
+func Halve(count, capacity int) int {
+	if count <= capacity/4 {
+		return capacity / 2
+	}
+
+	return capacity
+}
+
+// NeverShrink is a ShrinkPolicy keeping the capacity of the deque as is.
+func NeverShrink(_, capacity int) int { return capacity }
+
+// Deque is a double-ended queue. Pushing and popping from both ends run in amortized
+// O(1), as does indexing. The zero value is an empty deque ready to use with the
+// default policies.
+type Deque[V any] struct {
+	buffer []V
+	head   int
+	count  int
+	config config
+}
+
+// New makes an empty deque configured with the given options.
+func New[V any](options ...Option) *Deque[V] {
+	d := &Deque[V]{config: config{capacity: defaultCapacity, grow: Double, shrink: Halve}}
+
+	for _, option := range options {
+		option(&d.config)
+	}
+
+	d.buffer = make([]V, d.config.capacity)
+
+	return d
+}
+
+// Collect makes a new deque holding the given values, in order. The buffer is sized to
+// hold the values, but the minimum capacity is left as the default so the deque may
+// shrink after removals.
+func Collect[V any](values ...V) *Deque[V] {
+	d := New[V]()
+	d.buffer = make([]V, internal.Max(len(values), d.config.capacity))
+
+	for _, v := range values {
+		d.PushBack(v)
+	}
+
+	return d
+}
+
+// Count returns the number of elements on the deque.
+func (d *Deque[V]) Count() int { return d.count }
+
+// IsEmpty checks if the deque is empty.
+func (d *Deque[V]) IsEmpty() bool { return d.count == 0 }
+
+// Capacity returns the number of elements the deque holds before growing.
+func (d *Deque[V]) Capacity() int { return len(d.buffer) }
+
+// PushBack appends v to the back of the deque.
+func (d *Deque[V]) PushBack(v V) *Deque[V] {
+	d.growIfFull()
+	d.buffer[d.index(d.count)] = v
+	d.count++
+
+	return d
+}
+
+// PushFront prepends v to the front of the deque.
+func (d *Deque[V]) PushFront(v V) *Deque[V] {
+	d.growIfFull()
+	d.head = d.index(len(d.buffer) - 1)
+	d.buffer[d.head] = v
+	d.count++
+
+	return d
+}
+
+// PopBack calls PopBackE, omitting the error.
+func (d *Deque[V]) PopBack() V {
+	v, _ := d.PopBackE()
+	return v
+}
+
+// PopBackE removes and returns the element at the back of the deque. Should the deque
+// be empty, a zeroed V and an errors.EmptyCollectionError are returned.
+func (d *Deque[V]) PopBackE() (V, error) {
+	if d.count == 0 {
+		return *new(V), errors.NewEmptyCollectionError(errors.NewValueNotFoundError())
+	}
+
+	i := d.index(d.count - 1)
+	v := d.buffer[i]
+	d.buffer[i] = *new(V)
+	d.count--
+	d.shrinkIfSparse()
+
+	return v, nil
+}
+
+// PopFront calls PopFrontE, omitting the error.
+func (d *Deque[V]) PopFront() V {
+	v, _ := d.PopFrontE()
+	return v
+}
+
+// PopFrontE removes and returns the element at the front of the deque. Should the
+// deque be empty, a zeroed V and an errors.EmptyCollectionError are returned.
+func (d *Deque[V]) PopFrontE() (V, error) {
+	if d.count == 0 {
+		return *new(V), errors.NewEmptyCollectionError(errors.NewValueNotFoundError())
+	}
+
+	v := d.buffer[d.head]
+	d.buffer[d.head] = *new(V)
+	d.head = d.index(1)
+	d.count--
+	d.shrinkIfSparse()
+
+	return v, nil
+}
+
+// Front calls FrontE, omitting the error.
+func (d *Deque[V]) Front() V {
+	v, _ := d.FrontE()
+	return v
+}
+
+// FrontE returns the element at the front of the deque without removing it.
+func (d *Deque[V]) FrontE() (V, error) { return d.GetE(0) }
+
+// Back calls BackE, omitting the error.
+func (d *Deque[V]) Back() V {
+	v, _ := d.BackE()
+	return v
+}
+
+// BackE returns the element at the back of the deque without removing it.
+func (d *Deque[V]) BackE() (V, error) { return d.GetE(d.count - 1) }
+
+// Get calls GetE, omitting the error.
+func (d *Deque[V]) Get(i int) V {
+	v, _ := d.GetE(i)
+	return v
+}
+
+// GetE returns the element at the index i, counting from the front of the deque.
+// Errors are returned the same way as on collections.GetE.
+func (d *Deque[V]) GetE(i int) (V, error) {
+	if d.count == 0 {
+		return *new(V), errors.NewEmptyCollectionError(errors.NewValueNotFoundError())
+	}
+
+	if i < 0 || i >= d.count {
+		return *new(V), errors.NewIndexOutOfBoundsError(errors.NewValueNotFoundError())
+	}
+
+	return d.buffer[d.index(i)], nil
+}
+
+// Clear removes every element of the deque, resetting it to its initial capacity.
+func (d *Deque[V]) Clear() *Deque[V] {
+	d.ensureConfig()
+	d.buffer = make([]V, d.config.capacity)
+	d.head, d.count = 0, 0
+
+	return d
+}
+
+// Each calls f with every element of the deque, from front to back.
+func (d *Deque[V]) Each(f func(i int, v V)) *Deque[V] {
+	for i := 0; i < d.count; i++ {
+		f(i, d.buffer[d.index(i)])
+	}
+
+	return d
+}
+
+// Map returns a new deque holding the values returned by f.
+func (d *Deque[V]) Map(f func(i int, v V) V) *Deque[V] {
+	mapped := New[V](d.options()...)
+
+	d.Each(func(i int, v V) {
+		mapped.PushBack(f(i, v))
+	})
+
+	return mapped
+}
+
+// Filter returns a new deque holding only the elements matched by f.
+func (d *Deque[V]) Filter(f func(i int, v V) bool) *Deque[V] {
+	filtered := New[V](d.options()...)
+
+	d.Each(func(i int, v V) {
+		if f(i, v) {
+			filtered.PushBack(v)
+		}
+	})
+
+	return filtered
+}
+
+// Contains checks if the deque holds at least one value matching the given matcher.
+func (d *Deque[V]) Contains(matcher collections.Matcher[int, V]) bool {
+	for i := 0; i < d.count; i++ {
+		if matcher(i, d.buffer[d.index(i)]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ToSlice makes a new slice holding the elements of the deque, from front to back.
+func (d *Deque[V]) ToSlice() []V {
+	result := make([]V, d.count)
+
+	n := copy(result, d.buffer[d.head:internal.Min(d.head+d.count, len(d.buffer))])
+	copy(result[n:], d.buffer[:d.count-n])
+
+	return result
+}
+
+// ToSliceCollection returns ToSlice as a slice.Collection.
+func (d *Deque[V]) ToSliceCollection() slice.Collection[V] { return d.ToSlice() }
+
+func (d *Deque[V]) index(i int) int {
+	return (d.head + i) % len(d.buffer)
+}
+
+func (d *Deque[V]) growIfFull() {
+	d.ensureConfig()
+
+	if len(d.buffer) == 0 {
+		d.buffer = make([]V, d.config.capacity)
+	}
+
+	if d.count == len(d.buffer) {
+		// Policies not growing the buffer would make resize drop elements.
+		capacity := internal.Max(d.config.grow(len(d.buffer)), len(d.buffer)+1)
+		d.resize(capacity)
+	}
+}
+
+func (d *Deque[V]) shrinkIfSparse() {
+	if len(d.buffer) <= d.config.capacity {
+		return
+	}
+
+	capacity := d.config.shrink(d.count, len(d.buffer))
+	if capacity < d.config.capacity {
+		capacity = d.config.capacity
+	}
+
+	if capacity >= d.count && capacity < len(d.buffer) {
+		d.resize(capacity)
+	}
+}
+
+func (d *Deque[V]) resize(capacity int) {
+	buffer := make([]V, capacity)
+	copy(buffer, d.ToSlice())
+
+	d.buffer = buffer
+	d.head = 0
+}
+
+// ensureConfig sets the default configuration on zero valued deques.
+func (d *Deque[V]) ensureConfig() {
+	if d.config.capacity == 0 {
+		d.config = config{capacity: defaultCapacity, grow: Double, shrink: Halve}
+	}
+}
+
+func (d *Deque[V]) options() []Option {
+	d.ensureConfig()
+
+	return []Option{WithCapacity(d.config.capacity), WithGrowth(d.config.grow), WithShrink(d.config.shrink)}
+}
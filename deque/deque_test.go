@@ -0,0 +1,227 @@
+package deque
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thefuga/go-collections"
+)
+
+func TestPushAndPop(t *testing.T) {
+	testCases := []struct {
+		description string
+		operations  func(d *Deque[int]) []int
+		expected    []int
+		remaining   []int
+	}{
+		{
+			"push back, pop front",
+			func(d *Deque[int]) []int {
+				d.PushBack(1).PushBack(2).PushBack(3)
+				return []int{d.PopFront(), d.PopFront()}
+			},
+			[]int{1, 2},
+			[]int{3},
+		},
+		{
+			"push front, pop front",
+			func(d *Deque[int]) []int {
+				d.PushFront(1).PushFront(2).PushFront(3)
+				return []int{d.PopFront()}
+			},
+			[]int{3},
+			[]int{2, 1},
+		},
+		{
+			"push both ends, pop back",
+			func(d *Deque[int]) []int {
+				d.PushFront(1).PushBack(2).PushFront(0).PushBack(3)
+				return []int{d.PopBack(), d.PopBack()}
+			},
+			[]int{3, 2},
+			[]int{0, 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			d := New[int]()
+			popped := tc.operations(d)
+
+			if !reflect.DeepEqual(popped, tc.expected) {
+				t.Errorf("expected %v. got %v", tc.expected, popped)
+			}
+
+			if !reflect.DeepEqual(d.ToSlice(), tc.remaining) {
+				t.Errorf("expected %v. got %v", tc.remaining, d.ToSlice())
+			}
+		})
+	}
+}
+
+func TestZeroValue(t *testing.T) {
+	var d Deque[int]
+
+	d.PushFront(2).PushFront(1).PushBack(3)
+
+	if expected := []int{1, 2, 3}; !reflect.DeepEqual(d.ToSlice(), expected) {
+		t.Errorf("expected %v. got %v", expected, d.ToSlice())
+	}
+
+	if mapped := d.Map(func(_ int, v int) int { return v }); mapped.Capacity() != defaultCapacity {
+		t.Errorf("expected the default capacity. got %d", mapped.Capacity())
+	}
+}
+
+func TestPopEmpty(t *testing.T) {
+	d := New[int]()
+
+	if _, err := d.PopFrontE(); err == nil || err.Error() != "value not found: empty collection" {
+		t.Errorf("expected an empty collection error. got %v", err)
+	}
+
+	if _, err := d.PopBackE(); err == nil {
+		t.Error("expected an empty collection error")
+	}
+
+	if _, err := d.FrontE(); err == nil {
+		t.Error("expected an empty collection error")
+	}
+}
+
+func TestGrowAndShrink(t *testing.T) {
+	d := New[int](WithCapacity(4))
+
+	for i := 0; i < 100; i++ {
+		if i%2 == 0 {
+			d.PushBack(i)
+		} else {
+			d.PushFront(i)
+		}
+	}
+
+	if d.Capacity() != 128 {
+		t.Errorf("expected capacity to be 128. got %d", d.Capacity())
+	}
+
+	for i := 0; i < 100; i++ {
+		if v, err := d.GetE(i); err != nil || v != d.ToSlice()[i] {
+			t.Fatalf("unexpected value at %d: %v (%v)", i, v, err)
+		}
+	}
+
+	for d.Count() > 2 {
+		d.PopFront()
+	}
+
+	if d.Capacity() != 4 {
+		t.Errorf("expected capacity to be 4. got %d", d.Capacity())
+	}
+
+	if expected := []int{96, 98}; !reflect.DeepEqual(d.ToSlice(), expected) {
+		t.Errorf("expected %v. got %v", expected, d.ToSlice())
+	}
+
+	for !d.IsEmpty() {
+		d.PopBack()
+	}
+
+	if d.Capacity() != 4 {
+		t.Errorf("expected capacity not to go below the initial capacity. got %d", d.Capacity())
+	}
+}
+
+func TestPolicies(t *testing.T) {
+	d := New[int](
+		WithCapacity(2),
+		WithGrowth(func(capacity int) int { return capacity + 1 }),
+		WithShrink(NeverShrink),
+	)
+
+	for i := 0; i < 5; i++ {
+		d.PushBack(i)
+	}
+
+	if d.Capacity() != 5 {
+		t.Errorf("expected capacity to grow by one. got %d", d.Capacity())
+	}
+
+	d.Clear()
+
+	if d.Capacity() != 2 || !d.IsEmpty() {
+		t.Errorf("expected the deque to be reset. got %d elements and capacity %d", d.Count(), d.Capacity())
+	}
+}
+
+func TestGrowthPolicyNotGrowing(t *testing.T) {
+	d := New[int](WithCapacity(2), WithGrowth(func(capacity int) int { return capacity }))
+
+	for i := 0; i < 5; i++ {
+		d.PushBack(i)
+	}
+
+	if expected := []int{0, 1, 2, 3, 4}; !reflect.DeepEqual(d.ToSlice(), expected) {
+		t.Errorf("expected %v. got %v", expected, d.ToSlice())
+	}
+
+	if d.Capacity() != 5 {
+		t.Errorf("expected capacity to grow by one. got %d", d.Capacity())
+	}
+}
+
+func TestCollectShrinks(t *testing.T) {
+	d := Collect(make([]int, 100)...)
+
+	if d.Capacity() != 100 {
+		t.Errorf("expected capacity to be 100. got %d", d.Capacity())
+	}
+
+	for i := 0; i < 95; i++ {
+		d.PopBack()
+	}
+
+	if d.Capacity() >= 100 || d.Count() != 5 {
+		t.Errorf("expected the deque to shrink. got %d elements and capacity %d", d.Count(), d.Capacity())
+	}
+
+	if d.Clear().Capacity() != defaultCapacity {
+		t.Errorf("expected the default capacity after clearing. got %d", d.Capacity())
+	}
+}
+
+func TestGetE(t *testing.T) {
+	d := Collect(1, 2, 3)
+
+	if _, err := d.GetE(3); err == nil || err.Error() != "value not found: index out of bounds" {
+		t.Errorf("expected an index out of bounds error. got %v", err)
+	}
+
+	if d.Front() != 1 || d.Back() != 3 || d.Get(1) != 2 {
+		t.Errorf("unexpected elements %v", d.ToSlice())
+	}
+}
+
+func TestMapFilterContains(t *testing.T) {
+	d := New[int]().PushFront(2).PushFront(1).PushBack(3).PushBack(4)
+
+	mapped := d.Map(func(_ int, v int) int { return v * 10 })
+	if expected := []int{10, 20, 30, 40}; !reflect.DeepEqual(mapped.ToSlice(), expected) {
+		t.Errorf("expected %v. got %v", expected, mapped.ToSlice())
+	}
+
+	filtered := d.Filter(func(_ int, v int) bool { return v%2 == 0 })
+	if expected := []int{2, 4}; !reflect.DeepEqual([]int(filtered.ToSliceCollection()), expected) {
+		t.Errorf("expected %v. got %v", expected, filtered.ToSlice())
+	}
+
+	if !d.Contains(collections.ValueEquals[int](4)) || d.Contains(collections.ValueEquals[int](5)) {
+		t.Error("unexpected Contains result")
+	}
+
+	var indexes []int
+	d.Each(func(i int, _ int) { indexes = append(indexes, i) })
+
+	if expected := []int{0, 1, 2, 3}; !reflect.DeepEqual(indexes, expected) {
+		t.Errorf("expected %v. got %v", expected, indexes)
+	}
+}
@@ -0,0 +1,31 @@
+package deque
+
+import "fmt"
+
+func ExampleDeque() {
+	d := New[string]()
+	d.PushBack("b").PushBack("c").PushFront("a")
+
+	fmt.Println(d.ToSlice())
+	fmt.Println(d.PopFront(), d.PopBack())
+	fmt.Println(d.ToSlice())
+	// Output:
+	// [a b c]
+	// a c
+	// [b]
+}
+
+func ExampleDeque_PopFrontE() {
+	_, err := New[int]().PopFrontE()
+	fmt.Println(err)
+	// Output:
+	// value not found: empty collection
+}
+
+func ExampleDeque_Format() {
+	d := New[int]().PushBack(2).PushBack(3).PushFront(1)
+
+	fmt.Printf("%v %.1v\n", d, d)
+	// Output:
+	// [1 2 3] [1 ...]
+}
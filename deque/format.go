@@ -0,0 +1,33 @@
+package deque
+
+import (
+	"fmt"
+
+	"github.com/thefuga/go-collections/internal"
+)
+
+// String returns the deque formatted with %v.
+func (d *Deque[V]) String() string { return fmt.Sprint(d) }
+
+// Format implements fmt.Formatter, printing the elements from front to back. %v
+// prints the elements in a single line, %+v prints one element per line and %#v
+// prints the deque in Go syntax. The precision limits the number of elements printed
+// (e.g. %.10v). A nil deque prints <nil>.
+func (d *Deque[V]) Format(s fmt.State, verb rune) {
+	if d == nil {
+		fmt.Fprint(s, "<nil>")
+		return
+	}
+
+	internal.Format(s, verb, d, internal.Formatter{
+		Open:  "[",
+		Close: "]",
+		Each: func(f func(k, v any) bool) {
+			for i := 0; i < d.count; i++ {
+				if !f(i, d.buffer[d.index(i)]) {
+					return
+				}
+			}
+		},
+	})
+}
@@ -0,0 +1,36 @@
+package deque
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	var nilDeque *Deque[int]
+
+	testCases := []struct {
+		description string
+		format      string
+		deque       *Deque[int]
+		expected    string
+	}{
+		{"single line", "%v", Collect(2, 3).PushFront(1), "[1 2 3]"},
+		{"empty", "%v", New[int](), "[]"},
+		{"truncated", "%.2v", Collect(1, 2, 3), "[1 2 ...]"},
+		{"multi line", "%+v", Collect(1, 2), "[\n  1\n  2\n]"},
+		{"nil", "%v", nilDeque, "<nil>"},
+		{"nil string", "%s", nilDeque, "<nil>"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if actual := fmt.Sprintf(tc.format, tc.deque); actual != tc.expected {
+				t.Errorf("expected %q. got %q", tc.expected, actual)
+			}
+		})
+	}
+
+	if actual := nilDeque.String(); actual != "<nil>" {
+		t.Errorf("expected %q. got %q", "<nil>", actual)
+	}
+}
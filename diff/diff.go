@@ -0,0 +1,54 @@
+// Package diff computes structural differences between collections. Key based change
+// sets are produced for kv and ordered collections, while slices are compared
+// element-wise with Myers' algorithm, producing the shortest edit script between them.
+// Both can be applied as patches to reproduce the target collection and rendered as
+// unified-diff style strings, which is useful for test failures.
+package diff
+
+// Op identifies the kind of an edit or change.
+type Op int
+
+const (
+	// Keep means the element is present on both sides.
+	Keep Op = iota
+	// Insert means the element is only present on the target.
+	Insert
+	// Delete means the element is only present on the source.
+	Delete
+	// Update means the key is present on both sides, but with different values.
+	Update
+)
+
+// String returns the name of the operation.
+func (op Op) String() string {
+	switch op {
+	case Keep:
+		return "keep"
+	case Insert:
+		return "insert"
+	case Delete:
+		return "delete"
+	case Update:
+		return "update"
+	default:
+		return "unknown"
+	}
+}
+
+// moveSymbol prefixes the keys which changed positions on rendered change sets.
+const moveSymbol = "~"
+
+// symbol returns the prefix of the operation on rendered diffs. Update uses "!", as
+// context diffs do, leaving moveSymbol to moved keys.
+func (op Op) symbol() string {
+	switch op {
+	case Insert:
+		return "+"
+	case Delete:
+		return "-"
+	case Update:
+		return "!"
+	default:
+		return " "
+	}
+}
@@ -0,0 +1,27 @@
+package diff
+
+import "fmt"
+
+func ExampleSlice() {
+	script := Slice([]string{"a", "b", "c"}, []string{"a", "c", "d"})
+
+	fmt.Print(script)
+	// Output:
+	// --- from
+	// +++ to
+	// @@ -1,3 +1,3 @@
+	//  a
+	// -b
+	//  c
+	// +d
+}
+
+func ExampleScript_Apply() {
+	from := []int{1, 2, 3}
+	script := Slice(from, []int{0, 1, 3})
+
+	patched, err := script.Apply(from)
+	fmt.Println(patched, err)
+	// Output:
+	// [0 1 3] <nil>
+}
@@ -0,0 +1,245 @@
+package diff
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/thefuga/go-collections"
+	"github.com/thefuga/go-collections/errors"
+	"github.com/thefuga/go-collections/internal"
+	"github.com/thefuga/go-collections/kv"
+	"github.com/thefuga/go-collections/kv/ordered"
+)
+
+// Change describes a key added (Insert), removed (Delete) or which value changed (Update).
+// Old holds the source value (zeroed for insertions) and New holds the target value
+// (zeroed for deletions).
+type Change[K comparable, V any] struct {
+	Op  Op
+	Key K
+	Old V
+	New V
+}
+
+// ChangeSet holds the changes between two key-value collections. Order holds the
+// edit script between the keys of ordered collections and is nil for kv collections.
+type ChangeSet[K comparable, V any] struct {
+	Changes []Change[K, V]
+	Order   Script[K]
+}
+
+// Map calls MapFunc, comparing values with reflect.DeepEqual.
+func Map[K comparable, V any](from, to kv.Collection[K, V]) ChangeSet[K, V] {
+	return MapFunc(from, to, collections.DeepEquals[V]())
+}
+
+// MapFunc computes the changes between two kv collections, comparing values with equals.
+// As kv collections are not ordered, the changes are sorted by key, so the result is
+// deterministic: numbers and strings by value and anything else by its printed form.
+func MapFunc[K comparable, V any](
+	from, to kv.Collection[K, V], equals func(current, other V) bool,
+) ChangeSet[K, V] {
+	var changes []Change[K, V]
+
+	from.Each(func(k K, old V) {
+		if target, ok := to[k]; !ok {
+			changes = append(changes, Change[K, V]{Op: Delete, Key: k, Old: old})
+		} else if !equals(old, target) {
+			changes = append(changes, Change[K, V]{Op: Update, Key: k, Old: old, New: target})
+		}
+	})
+
+	to.Each(func(k K, target V) {
+		if _, ok := from[k]; !ok {
+			changes = append(changes, Change[K, V]{Op: Insert, Key: k, New: target})
+		}
+	})
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return internal.LessKey(changes[i].Key, changes[j].Key)
+	})
+
+	return ChangeSet[K, V]{Changes: changes}
+}
+
+// Ordered calls OrderedFunc, comparing values with reflect.DeepEqual.
+func Ordered[K comparable, V any](from, to ordered.Collection[K, V]) ChangeSet[K, V] {
+	return OrderedFunc(from, to, collections.DeepEquals[V]())
+}
+
+// OrderedFunc computes the changes between two ordered collections, comparing values
+// with equals. Deleted and updated keys follow the order of from, followed by the
+// inserted keys in the order of to. The edit script between the keys of both
+// collections is stored as the change set Order, which allows keys changing positions
+// to be detected and reproduced.
+func OrderedFunc[K comparable, V any](
+	from, to ordered.Collection[K, V], equals func(current, other V) bool,
+) ChangeSet[K, V] {
+	var changes []Change[K, V]
+
+	from.Each(func(k K, old V) {
+		if target, err := to.GetE(k); err != nil {
+			changes = append(changes, Change[K, V]{Op: Delete, Key: k, Old: old})
+		} else if !equals(old, target) {
+			changes = append(changes, Change[K, V]{Op: Update, Key: k, Old: old, New: target})
+		}
+	})
+
+	to.Each(func(k K, target V) {
+		if _, err := from.GetE(k); err != nil {
+			changes = append(changes, Change[K, V]{Op: Insert, Key: k, New: target})
+		}
+	})
+
+	return ChangeSet[K, V]{Changes: changes, Order: Slice(from.Keys(), to.Keys())}
+}
+
+// HasChanges checks if any key was added, removed, updated or moved.
+func (c ChangeSet[K, V]) HasChanges() bool {
+	return len(c.Changes) > 0 || c.Order.HasChanges()
+}
+
+// Added returns the changes of inserted keys.
+func (c ChangeSet[K, V]) Added() []Change[K, V] { return c.only(Insert) }
+
+// Removed returns the changes of deleted keys.
+func (c ChangeSet[K, V]) Removed() []Change[K, V] { return c.only(Delete) }
+
+// Updated returns the changes of keys which values changed.
+func (c ChangeSet[K, V]) Updated() []Change[K, V] { return c.only(Update) }
+
+// Moved returns the keys present on both collections which changed positions.
+// It is always empty for change sets of kv collections.
+func (c ChangeSet[K, V]) Moved() []Move[K] { return c.Order.Moves() }
+
+func (c ChangeSet[K, V]) only(op Op) []Change[K, V] {
+	var changes []Change[K, V]
+
+	for _, change := range c.Changes {
+		if change.Op == op {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes
+}
+
+// ApplyMap applies the changes to a copy of from, returning the patched collection.
+// Should a deleted or updated key not hold the expected value (compared with reflect.DeepEqual),
+// or an inserted key already exist, an instance of errors.PatchConflictError is returned.
+func (c ChangeSet[K, V]) ApplyMap(from kv.Collection[K, V]) (kv.Collection[K, V], error) {
+	patched := from.Copy()
+
+	if err := c.apply(patched); err != nil {
+		return nil, err
+	}
+
+	return patched, nil
+}
+
+// ApplyOrdered applies the changes to from, returning a new patched collection. When
+// the change set was computed from ordered collections, the keys order is also
+// reproduced. Otherwise, the order of from is preserved and inserted keys are
+// appended. Conflicts are reported the same way as on ApplyMap.
+func (c ChangeSet[K, V]) ApplyOrdered(
+	from ordered.Collection[K, V],
+) (ordered.Collection[K, V], error) {
+	values := make(kv.Collection[K, V], from.Count())
+	from.Each(func(k K, v V) { values.Put(k, v) })
+
+	if err := c.apply(values); err != nil {
+		return ordered.Collection[K, V]{}, err
+	}
+
+	keys, err := c.patchedKeys(from.Keys())
+	if err != nil {
+		return ordered.Collection[K, V]{}, err
+	}
+
+	patched := ordered.CollectMap(make(map[K]V, len(keys)))
+	for _, k := range keys {
+		patched.Put(k, values[k])
+	}
+
+	return patched, nil
+}
+
+func (c ChangeSet[K, V]) apply(values kv.Collection[K, V]) error {
+	for _, change := range c.Changes {
+		current, ok := values[change.Key]
+
+		switch change.Op {
+		case Insert:
+			if ok {
+				return errors.NewPatchConflictError(change.Key)
+			}
+			values.Put(change.Key, change.New)
+		case Update:
+			if !ok || !reflect.DeepEqual(current, change.Old) {
+				return errors.NewPatchConflictError(change.Key)
+			}
+			values.Put(change.Key, change.New)
+		case Delete:
+			if !ok || !reflect.DeepEqual(current, change.Old) {
+				return errors.NewPatchConflictError(change.Key)
+			}
+			values.Forget(change.Key)
+		}
+	}
+
+	return nil
+}
+
+func (c ChangeSet[K, V]) patchedKeys(keys []K) ([]K, error) {
+	if c.Order != nil {
+		return c.Order.Apply(keys)
+	}
+
+	patched := make([]K, 0, len(keys))
+	removed := make(map[K]struct{})
+
+	for _, change := range c.Removed() {
+		removed[change.Key] = struct{}{}
+	}
+
+	for _, k := range keys {
+		if _, ok := removed[k]; !ok {
+			patched = append(patched, k)
+		}
+	}
+
+	for _, change := range c.Added() {
+		patched = append(patched, change.Key)
+	}
+
+	return patched, nil
+}
+
+// String renders the change set as a unified diff. Updates are rendered as a deletion
+// followed by an insertion and moved keys are rendered with a "~" prefix, which no
+// operation uses.
+func (c ChangeSet[K, V]) String() string {
+	var builder strings.Builder
+
+	builder.WriteString("--- from\n+++ to\n")
+
+	for _, change := range c.Changes {
+		switch change.Op {
+		case Insert:
+			fmt.Fprintf(&builder, "%s%v: %v\n", Insert.symbol(), change.Key, change.New)
+		case Delete:
+			fmt.Fprintf(&builder, "%s%v: %v\n", Delete.symbol(), change.Key, change.Old)
+		case Update:
+			fmt.Fprintf(&builder, "%s%v: %v\n", Delete.symbol(), change.Key, change.Old)
+			fmt.Fprintf(&builder, "%s%v: %v\n", Insert.symbol(), change.Key, change.New)
+		}
+	}
+
+	for _, move := range c.Moved() {
+		fmt.Fprintf(&builder, "%s%v: moved from %d to %d\n", moveSymbol, move.Value, move.From, move.To)
+	}
+
+	return builder.String()
+}
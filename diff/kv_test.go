@@ -0,0 +1,181 @@
+package diff
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/thefuga/go-collections/kv"
+	"github.com/thefuga/go-collections/kv/ordered"
+)
+
+func TestMap(t *testing.T) {
+	from := kv.CollectMap(map[string]int{"a": 1, "b": 2, "c": 3})
+	to := kv.CollectMap(map[string]int{"a": 1, "b": 20, "d": 4})
+
+	changes := Map(from, to)
+
+	expected := []Change[string, int]{
+		{Op: Update, Key: "b", Old: 2, New: 20},
+		{Op: Delete, Key: "c", Old: 3},
+		{Op: Insert, Key: "d", New: 4},
+	}
+
+	if !reflect.DeepEqual(changes.Changes, expected) {
+		t.Errorf("expected changes to be %v. got %v", expected, changes.Changes)
+	}
+
+	if changes.Order != nil || len(changes.Moved()) != 0 {
+		t.Error("kv change sets must not hold any ordering")
+	}
+
+	if added := changes.Added(); len(added) != 1 || added[0].Key != "d" {
+		t.Errorf("expected d to be added. got %v", added)
+	}
+
+	if removed := changes.Removed(); len(removed) != 1 || removed[0].Key != "c" {
+		t.Errorf("expected c to be removed. got %v", removed)
+	}
+
+	if updated := changes.Updated(); len(updated) != 1 || updated[0].Key != "b" {
+		t.Errorf("expected b to be updated. got %v", updated)
+	}
+
+	patched, err := changes.ApplyMap(from)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(patched, to) {
+		t.Errorf("expected patched collection to be %v. got %v", to, patched)
+	}
+
+	if from["b"] != 2 {
+		t.Error("the source collection must be left untouched")
+	}
+}
+
+func TestMapSortsNumericKeys(t *testing.T) {
+	changes := Map(kv.Collection[int, int]{}, kv.Collection[int, int]{10: 1, 9: 1, 100: 1})
+
+	var keys []int
+	for _, change := range changes.Changes {
+		keys = append(keys, change.Key)
+	}
+
+	if expected := []int{9, 10, 100}; !reflect.DeepEqual(keys, expected) {
+		t.Errorf("expected %v. got %v", expected, keys)
+	}
+}
+
+func TestMapFunc(t *testing.T) {
+	from := kv.CollectMap(map[string]string{"a": "foo"})
+	to := kv.CollectMap(map[string]string{"a": "FOO"})
+
+	if changes := MapFunc(from, to, strings.EqualFold); changes.HasChanges() {
+		t.Errorf("expected no changes. got %v", changes.Changes)
+	}
+}
+
+func TestApplyMapConflicts(t *testing.T) {
+	changes := Map(
+		kv.CollectMap(map[string]int{"a": 1, "b": 2}),
+		kv.CollectMap(map[string]int{"a": 10, "c": 3}),
+	)
+
+	testCases := []struct {
+		description string
+		from        kv.Collection[string, int]
+	}{
+		{"updated key with a different value", kv.CollectMap(map[string]int{"a": 5, "b": 2})},
+		{"deleted key missing", kv.CollectMap(map[string]int{"a": 1})},
+		{"inserted key already present", kv.CollectMap(map[string]int{"a": 1, "b": 2, "c": 3})},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if _, err := changes.ApplyMap(tc.from); err == nil {
+				t.Error("expected a patch conflict error")
+			}
+		})
+	}
+}
+
+func TestOrdered(t *testing.T) {
+	from := ordered.CollectMap(map[string]int{})
+	from.Put("a", 1)
+	from.Put("b", 2)
+	from.Put("c", 3)
+
+	to := ordered.CollectMap(map[string]int{})
+	to.Put("c", 3)
+	to.Put("a", 10)
+	to.Put("d", 4)
+
+	changes := Ordered(from, to)
+
+	expected := []Change[string, int]{
+		{Op: Update, Key: "a", Old: 1, New: 10},
+		{Op: Delete, Key: "b", Old: 2},
+		{Op: Insert, Key: "d", New: 4},
+	}
+
+	if !reflect.DeepEqual(changes.Changes, expected) {
+		t.Errorf("expected changes to be %v. got %v", expected, changes.Changes)
+	}
+
+	if moved := changes.Moved(); len(moved) != 1 || moved[0].Value != "a" {
+		t.Errorf("expected a to be moved. got %v", moved)
+	}
+
+	patched, err := changes.ApplyOrdered(from)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(patched.Keys(), to.Keys()) {
+		t.Errorf("expected patched keys to be %v. got %v", to.Keys(), patched.Keys())
+	}
+
+	if !reflect.DeepEqual(patched.ToSlice(), to.ToSlice()) {
+		t.Errorf("expected patched values to be %v. got %v", to.ToSlice(), patched.ToSlice())
+	}
+}
+
+func TestOrderedWithoutChanges(t *testing.T) {
+	c := ordered.Collect(1, 2, 3)
+
+	if changes := Ordered(c, c); changes.HasChanges() {
+		t.Errorf("expected no changes. got %v", changes)
+	}
+}
+
+func TestApplyOrderedWithMapChanges(t *testing.T) {
+	changes := Map(
+		kv.CollectMap(map[int]string{0: "a", 1: "b"}),
+		kv.CollectMap(map[int]string{0: "a", 2: "c"}),
+	)
+
+	patched, err := changes.ApplyOrdered(ordered.Collect("a", "b"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := []string{"a", "c"}; !reflect.DeepEqual(patched.ToSlice(), expected) {
+		t.Errorf("expected %v. got %v", expected, patched.ToSlice())
+	}
+}
+
+func TestChangeSetString(t *testing.T) {
+	from := ordered.Collect("x", "y", "z")
+	to := ordered.CollectMap(map[int]string{})
+	to.Put(1, "Y")
+	to.Put(0, "x")
+	to.Put(3, "w")
+
+	expected := "--- from\n+++ to\n-1: y\n+1: Y\n-2: z\n+3: w\n~0: moved from 0 to 1\n"
+
+	if rendered := Ordered(from, to).String(); rendered != expected {
+		t.Errorf("expected %q. got %q", expected, rendered)
+	}
+}
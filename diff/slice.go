@@ -0,0 +1,234 @@
+package diff
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/thefuga/go-collections"
+	"github.com/thefuga/go-collections/errors"
+)
+
+// Edit is a single step of an edit script. OldIndex is the position of the element
+// on the source slice, or -1 for insertions. NewIndex is the position of the element
+// on the target slice, or -1 for deletions. Old holds the source value (zeroed for
+// insertions) and New holds the target value (zeroed for deletions).
+type Edit[V any] struct {
+	Op       Op
+	OldIndex int
+	NewIndex int
+	Old      V
+	New      V
+}
+
+// Move describes an element deleted from one position of the source slice and
+// inserted on another position of the target slice.
+type Move[V any] struct {
+	Value V
+	From  int
+	To    int
+}
+
+// Script is an edit script transforming a source slice into a target slice. Every
+// element of both slices is covered by exactly one edit, in order.
+type Script[V any] []Edit[V]
+
+// Slice calls SliceFunc, comparing elements with ==.
+func Slice[V comparable](from, to []V) Script[V] {
+	return SliceFunc(from, to, collections.Equals[V]())
+}
+
+// SliceFunc computes the shortest edit script transforming from into to using Myers'
+// algorithm, which runs in O((n+m)d), where d is the number of insertions and deletions.
+// Elements are compared with equals.
+func SliceFunc[V any](from, to []V, equals func(current, other V) bool) Script[V] {
+	n, m := len(from), len(to)
+	offset := n + m + 1
+	furthest := make([]int, 2*offset+1)
+	var trace [][]int
+
+	for d := 0; d <= n+m; d++ {
+		trace = append(trace, snapshot(furthest, offset, d))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && furthest[offset+k-1] < furthest[offset+k+1]) {
+				x = furthest[offset+k+1]
+			} else {
+				x = furthest[offset+k-1] + 1
+			}
+
+			y := x - k
+			for x < n && y < m && equals(from[x], to[y]) {
+				x, y = x+1, y+1
+			}
+
+			furthest[offset+k] = x
+
+			if x >= n && y >= m {
+				return backtrack(from, to, trace)
+			}
+		}
+	}
+
+	return Script[V]{}
+}
+
+// snapshot copies the furthest reaching x of every diagonal that may be read when
+// backtracking round d.
+func snapshot(furthest []int, offset, d int) []int {
+	copied := make([]int, 2*d+3)
+	copy(copied, furthest[offset-d-1:offset+d+2])
+	return copied
+}
+
+func backtrack[V any](from, to []V, trace [][]int) Script[V] {
+	x, y := len(from), len(to)
+	script := make(Script[V], 0, len(from)+len(to))
+
+	for d := len(trace) - 1; d >= 0; d-- {
+		furthest := func(k int) int { return trace[d][k+d+1] }
+		k := x - y
+
+		var prevK int
+		if k == -d || (k != d && furthest(k-1) < furthest(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+
+		prevX := furthest(prevK)
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x, y = x-1, y-1
+			script = append(script, Edit[V]{Op: Keep, OldIndex: x, NewIndex: y, Old: from[x], New: to[y]})
+		}
+
+		if d == 0 {
+			break
+		}
+
+		if x == prevX {
+			y--
+			script = append(script, Edit[V]{Op: Insert, OldIndex: -1, NewIndex: y, New: to[y]})
+		} else {
+			x--
+			script = append(script, Edit[V]{Op: Delete, OldIndex: x, NewIndex: -1, Old: from[x]})
+		}
+	}
+
+	for i, j := 0, len(script)-1; i < j; i, j = i+1, j-1 {
+		script[i], script[j] = script[j], script[i]
+	}
+
+	return script
+}
+
+// HasChanges checks if the script has at least one insertion or deletion.
+func (s Script[V]) HasChanges() bool {
+	for _, edit := range s {
+		if edit.Op != Keep {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Apply applies the script to from, returning the target slice. Every kept and deleted
+// element is checked against from with reflect.DeepEqual. Should from not correspond
+// to the script source, an instance of errors.PatchConflictError is returned.
+func (s Script[V]) Apply(from []V) ([]V, error) {
+	patched := make([]V, 0, len(from))
+	i := 0
+
+	for _, edit := range s {
+		if edit.Op == Insert {
+			patched = append(patched, edit.New)
+			continue
+		}
+
+		if i >= len(from) || !reflect.DeepEqual(from[i], edit.Old) {
+			return nil, errors.NewPatchConflictError(i)
+		}
+
+		if edit.Op == Keep {
+			patched = append(patched, edit.New)
+		}
+
+		i++
+	}
+
+	if i != len(from) {
+		return nil, errors.NewPatchConflictError(i)
+	}
+
+	return patched, nil
+}
+
+// Moves pairs deletions and insertions of equal elements (compared with reflect.DeepEqual),
+// reporting them as moves. Each deletion is paired with the first unpaired insertion
+// of an equal element.
+func (s Script[V]) Moves() []Move[V] {
+	var moves []Move[V]
+	paired := make(map[int]struct{})
+
+	for _, deletion := range s {
+		if deletion.Op != Delete {
+			continue
+		}
+
+		for i, insertion := range s {
+			if _, ok := paired[i]; ok || insertion.Op != Insert {
+				continue
+			}
+
+			if reflect.DeepEqual(deletion.Old, insertion.New) {
+				paired[i] = struct{}{}
+				moves = append(moves, Move[V]{Value: deletion.Old, From: deletion.OldIndex, To: insertion.NewIndex})
+				break
+			}
+		}
+	}
+
+	return moves
+}
+
+// String renders the script as a unified diff with a single hunk holding every element.
+func (s Script[V]) String() string {
+	var (
+		builder            strings.Builder
+		oldCount, newCount int
+	)
+
+	for _, edit := range s {
+		if edit.Op != Insert {
+			oldCount++
+		}
+		if edit.Op != Delete {
+			newCount++
+		}
+	}
+
+	fmt.Fprintf(&builder, "--- from\n+++ to\n@@ -%s +%s @@\n", hunkRange(oldCount), hunkRange(newCount))
+
+	for _, edit := range s {
+		value := edit.New
+		if edit.Op == Delete {
+			value = edit.Old
+		}
+
+		fmt.Fprintf(&builder, "%s%v\n", edit.Op.symbol(), value)
+	}
+
+	return builder.String()
+}
+
+func hunkRange(count int) string {
+	if count == 0 {
+		return "0,0"
+	}
+
+	return fmt.Sprintf("1,%d", count)
+}
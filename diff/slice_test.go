@@ -0,0 +1,165 @@
+package diff
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSlice(t *testing.T) {
+	testCases := []struct {
+		description string
+		from        []string
+		to          []string
+		expectedOps []Op
+	}{
+		{"both empty", []string{}, []string{}, []Op{}},
+		{"only insertions", []string{}, []string{"a", "b"}, []Op{Insert, Insert}},
+		{"only deletions", []string{"a", "b"}, []string{}, []Op{Delete, Delete}},
+		{"equal slices", []string{"a", "b"}, []string{"a", "b"}, []Op{Keep, Keep}},
+		{
+			"mixed edits",
+			[]string{"a", "b", "c", "a", "b", "b", "a"},
+			[]string{"c", "b", "a", "b", "a", "c"},
+			[]Op{Delete, Delete, Keep, Insert, Keep, Keep, Delete, Keep, Insert},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			script := Slice(tc.from, tc.to)
+
+			ops := make([]Op, len(script))
+			for i, edit := range script {
+				ops[i] = edit.Op
+			}
+
+			if !reflect.DeepEqual(ops, tc.expectedOps) {
+				t.Errorf("expected ops to be %v. got %v", tc.expectedOps, ops)
+			}
+
+			patched, err := script.Apply(tc.from)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(tc.to) > 0 && !reflect.DeepEqual(patched, tc.to) {
+				t.Errorf("expected patched slice to be %v. got %v", tc.to, patched)
+			}
+		})
+	}
+}
+
+func TestSliceIsShortest(t *testing.T) {
+	from := []int{1, 2, 3, 4, 5, 6}
+	to := []int{0, 1, 2, 4, 5, 6, 7}
+
+	changes := 0
+	for _, edit := range Slice(from, to) {
+		if edit.Op != Keep {
+			changes++
+		}
+	}
+
+	if changes != 3 {
+		t.Errorf("expected 3 insertions and deletions. got %d", changes)
+	}
+}
+
+func TestSliceApplyReproducesTarget(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+
+	for i := 0; i < 100; i++ {
+		from := make([]int, random.Intn(30))
+		for j := range from {
+			from[j] = random.Intn(5)
+		}
+
+		to := make([]int, random.Intn(30))
+		for j := range to {
+			to[j] = random.Intn(5)
+		}
+
+		patched, err := Slice(from, to).Apply(from)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(to) == 0 && len(patched) == 0 {
+			continue
+		}
+
+		if !reflect.DeepEqual(patched, to) {
+			t.Fatalf("expected %v to be patched into %v. got %v", from, to, patched)
+		}
+	}
+}
+
+func TestSliceFunc(t *testing.T) {
+	script := SliceFunc([]string{"A", "b"}, []string{"a", "B"}, strings.EqualFold)
+
+	if script.HasChanges() {
+		t.Errorf("expected no changes. got %v", script)
+	}
+
+	patched, err := script.Apply([]string{"A", "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := []string{"a", "B"}; !reflect.DeepEqual(patched, expected) {
+		t.Errorf("expected kept values to be taken from the target. got %v", patched)
+	}
+}
+
+func TestApplyConflict(t *testing.T) {
+	script := Slice([]int{1, 2, 3}, []int{1, 3})
+
+	testCases := []struct {
+		description string
+		from        []int
+	}{
+		{"different values", []int{1, 5, 3}},
+		{"shorter source", []int{1, 2}},
+		{"longer source", []int{1, 2, 3, 4}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			_, err := script.Apply(tc.from)
+			if err == nil || !strings.HasPrefix(err.Error(), "patch conflict at") {
+				t.Errorf("expected a patch conflict error. got %v", err)
+			}
+		})
+	}
+}
+
+func TestMoves(t *testing.T) {
+	script := Slice([]string{"a", "b", "c", "d"}, []string{"b", "c", "a", "d"})
+	expected := []Move[string]{{Value: "a", From: 0, To: 2}}
+
+	if moves := script.Moves(); !reflect.DeepEqual(moves, expected) {
+		t.Errorf("expected moves to be %v. got %v", expected, moves)
+	}
+}
+
+func TestScriptString(t *testing.T) {
+	expected := "--- from\n+++ to\n@@ -1,3 +1,3 @@\n a\n-b\n c\n+d\n"
+
+	if rendered := Slice([]string{"a", "b", "c"}, []string{"a", "c", "d"}).String(); rendered != expected {
+		t.Errorf("expected %q. got %q", expected, rendered)
+	}
+}
+
+func TestSymbolsAreDistinct(t *testing.T) {
+	symbols := map[string]Op{}
+
+	for _, op := range []Op{Keep, Insert, Delete, Update} {
+		if other, ok := symbols[op.symbol()]; ok || op.symbol() == moveSymbol {
+			t.Errorf("expected %v to have its own symbol. got %q, shared with %v", op, op.symbol(), other)
+		}
+
+		symbols[op.symbol()] = op
+	}
+}
@@ -47,12 +47,76 @@ func NewIndexOutOfBoundsError(cause ...error) error {
 	return wrap("index out of bounds", nil, cause)
 }
 
+type FullCollectionError error
+
+func NewFullCollectionError(cause ...error) error {
+	return wrap("full collection", nil, cause)
+}
+
+type KeyConflictError error
+
+func NewKeyConflictError(k any, cause ...error) error {
+	return wrap("key '%v' is already mapped to another value", []any{k}, cause)
+}
+
+type ValueConflictError error
+
+func NewValueConflictError(v any, cause ...error) error {
+	return wrap("value '%v' is already mapped to another key", []any{v}, cause)
+}
+
+type UnsupportedVersionError error
+
+func NewUnsupportedVersionError(version any, cause ...error) error {
+	return wrap("unsupported encoding version '%v'", []any{version}, cause)
+}
+
+type InvalidEncodingError error
+
+func NewInvalidEncodingError(cause ...error) error {
+	return wrap("invalid encoding", nil, cause)
+}
+
+type NoPathError error
+
+func NewNoPathError(from, to any, cause ...error) error {
+	return wrap("no path from '%v' to '%v'", []any{from, to}, cause)
+}
+
+type NegativeWeightError error
+
+func NewNegativeWeightError(from, to any, weight float64, cause ...error) error {
+	return wrap("negative weight %v from '%v' to '%v'", []any{weight, from, to}, cause)
+}
+
 type KeysValuesLengthMismatch error
 
 func NewKeysValuesLengthMismatch(cause ...error) error {
 	return wrap("keys and values don't have the same length", nil, cause)
 }
 
+type PatchConflictError error
+
+func NewPatchConflictError(at any, cause ...error) error {
+	return wrap("patch conflict at '%v'", []any{at}, cause)
+}
+
+type InvalidCursorError error
+
+func NewInvalidCursorError(cursor any, cause ...error) error {
+	return wrap("invalid cursor '%v'", []any{cursor}, cause)
+}
+
+type InterruptedError error
+
+func NewInterruptedError(processed int, cause ...error) error {
+	return wrap("interrupted after processing %d items", []any{processed}, cause)
+}
+
+// Stop may be returned by the callbacks given to EachE functions and methods to stop
+// the iteration early. It is never returned by them.
+var Stop = fmt.Errorf("stop iteration")
+
 func wrap(format string, args []any, cause []error) error {
 	msg := fmt.Sprintf(format, args...)
 
@@ -1,6 +1,7 @@
 package collections
 
 import (
+	stdErrors "errors"
 	"math/rand"
 	"reflect"
 	"sort"
@@ -144,6 +145,32 @@ func Each[T any](f func(i int, v T), slice []T) {
 	}
 }
 
+// EachWhile calls f with every item of the slice, in order, until f returns false.
+func EachWhile[T any](f func(i int, v T) bool, slice []T) {
+	for i, v := range slice {
+		if !f(i, v) {
+			return
+		}
+	}
+}
+
+// EachE calls f with every item of the slice, in order, until f returns an error.
+// Should the error be (or wrap) errors.Stop, nil is returned. Otherwise, the error is
+// returned.
+func EachE[T any](f func(i int, v T) error, slice []T) error {
+	for i, v := range slice {
+		if err := f(i, v); err != nil {
+			if stdErrors.Is(err, errors.Stop) {
+				return nil
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Search uses SearchE, omitting the error.
 func Search[T any](v T, slice []T) int {
 	i, _ := SearchE(slice, v)
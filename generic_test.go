@@ -242,6 +242,60 @@ func TestEach(t *testing.T) {
 	}
 }
 
+func TestEachWhile(t *testing.T) {
+	var eachResult []int
+
+	EachWhile(func(_ int, v int) bool {
+		eachResult = append(eachResult, v)
+		return v < 2
+	}, []int{1, 2, 3})
+
+	if expected := []int{1, 2}; !reflect.DeepEqual(eachResult, expected) {
+		t.Errorf("expected visited values to be %v. got %v", expected, eachResult)
+	}
+}
+
+func TestEachE(t *testing.T) {
+	failure := fmt.Errorf("failure")
+
+	testCases := []struct {
+		description string
+		stopAt      int
+		stopWith    error
+		visited     []int
+		err         error
+	}{
+		{"visiting every value", 4, nil, []int{1, 2, 3}, nil},
+		{"stopping with errors.Stop", 2, errors.Stop, []int{1, 2}, nil},
+		{"stopping with a wrapped errors.Stop", 1, fmt.Errorf("done: %w", errors.Stop), []int{1}, nil},
+		{"stopping with an error", 2, failure, []int{1, 2}, failure},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			var visited []int
+
+			err := EachE(func(_ int, v int) error {
+				visited = append(visited, v)
+
+				if v == tc.stopAt {
+					return tc.stopWith
+				}
+
+				return nil
+			}, []int{1, 2, 3})
+
+			if err != tc.err {
+				t.Errorf("expected error %v. got %v", tc.err, err)
+			}
+
+			if !reflect.DeepEqual(visited, tc.visited) {
+				t.Errorf("expected visited values to be %v. got %v", tc.visited, visited)
+			}
+		})
+	}
+}
+
 func TestSearch(t *testing.T) {
 	testCases := []struct {
 		description string
@@ -0,0 +1,261 @@
+package graph
+
+import (
+	"fmt"
+
+	"github.com/thefuga/go-collections"
+	"github.com/thefuga/go-collections/deque"
+	"github.com/thefuga/go-collections/errors"
+	"github.com/thefuga/go-collections/queue"
+	"github.com/thefuga/go-collections/slice"
+)
+
+// CycleError is returned by TopologicalSort when the graph has a cycle. Cycle holds
+// the nodes of the cycle, starting and ending at the same node.
+type CycleError[N comparable] struct {
+	Cycle []N
+}
+
+func (e CycleError[N]) Error() string {
+	return fmt.Sprintf("graph has a cycle: %v", e.Cycle)
+}
+
+// BFS visits the nodes reachable from start in breadth-first order, calling f with
+// every node and its distance, in edges, from start. The traversal stops once f
+// returns false. Should start not be on the graph, f is never called.
+func (g *Graph[N]) BFS(start N, f func(n N, depth int) bool) *Graph[N] {
+	if !g.HasNode(start) {
+		return g
+	}
+
+	type visit struct {
+		node  N
+		depth int
+	}
+
+	visited := map[N]struct{}{start: {}}
+	pending := deque.Collect(visit{start, 0})
+
+	for !pending.IsEmpty() {
+		current := pending.PopFront()
+
+		if !f(current.node, current.depth) {
+			return g
+		}
+
+		for _, e := range g.out[current.node] {
+			if _, ok := visited[e.To]; !ok {
+				visited[e.To] = struct{}{}
+				pending.PushBack(visit{e.To, current.depth + 1})
+			}
+		}
+	}
+
+	return g
+}
+
+// DFS visits the nodes reachable from start in depth-first pre-order, calling f with
+// every node. The traversal stops once f returns false. Should start not be on the
+// graph, f is never called.
+func (g *Graph[N]) DFS(start N, f func(n N) bool) *Graph[N] {
+	if !g.HasNode(start) {
+		return g
+	}
+
+	visited := make(map[N]struct{})
+	pending := []N{start}
+
+	for len(pending) > 0 {
+		current := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		if _, ok := visited[current]; ok {
+			continue
+		}
+
+		visited[current] = struct{}{}
+
+		if !f(current) {
+			return g
+		}
+
+		// Pushed in reverse, so neighbors are visited in insertion order.
+		edges := g.out[current]
+		for i := len(edges) - 1; i >= 0; i-- {
+			if _, ok := visited[edges[i].To]; !ok {
+				pending = append(pending, edges[i].To)
+			}
+		}
+	}
+
+	return g
+}
+
+// TopologicalSort returns the nodes ordered so that every edge goes from a node to a
+// later one. Should the graph have a cycle, a CycleError is returned. As undirected
+// edges go both ways, undirected graphs with any edges always have cycles.
+func (g *Graph[N]) TopologicalSort() (slice.Collection[N], error) {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+
+	state := make(map[N]int, len(g.nodes))
+	parent := make(map[N]N, len(g.nodes))
+	sorted := make(slice.Collection[N], len(g.nodes))
+	next := len(g.nodes) - 1
+
+	var visit func(n N) error
+	visit = func(n N) error {
+		state[n] = visiting
+
+		for _, e := range g.out[n] {
+			switch state[e.To] {
+			case visiting:
+				return CycleError[N]{Cycle: cycle(parent, n, e.To)}
+			case unvisited:
+				parent[e.To] = n
+				if err := visit(e.To); err != nil {
+					return err
+				}
+			}
+		}
+
+		state[n] = done
+		sorted[next] = n
+		next--
+
+		return nil
+	}
+
+	for _, n := range g.nodes {
+		if state[n] == unvisited {
+			if err := visit(n); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return sorted, nil
+}
+
+// cycle walks the DFS tree back from the last node of the cycle to its first one.
+func cycle[N comparable](parent map[N]N, last, first N) []N {
+	nodes := []N{first}
+
+	for n := last; n != first; n = parent[n] {
+		nodes = append(nodes, n)
+	}
+
+	return collections.Reverse(append(nodes, first))
+}
+
+// ShortestPath finds the path with the lowest total weight from from to to using
+// Dijkstra's algorithm, returning its nodes, including from and to, and its cost.
+// Weights must not be negative: should any edge of the graph weigh less than 0, an
+// instance of errors.NegativeWeightError is returned, even if the search would not
+// reach it. Should to not be reachable from from, an instance of errors.NoPathError
+// is returned.
+func (g *Graph[N]) ShortestPath(from, to N) (slice.Collection[N], float64, error) {
+	type candidate struct {
+		node N
+		cost float64
+	}
+
+	for _, n := range g.nodes {
+		for _, e := range g.out[n] {
+			if e.Weight < 0 {
+				return nil, 0, errors.NewNegativeWeightError(e.From, e.To, e.Weight)
+			}
+		}
+	}
+
+	if !g.HasNode(from) || !g.HasNode(to) {
+		return nil, 0, errors.NewNoPathError(from, to)
+	}
+
+	costs := map[N]float64{from: 0}
+	previous := make(map[N]N)
+	settled := make(map[N]struct{})
+
+	pending := queue.New(func(current, other candidate) bool {
+		return current.cost < other.cost
+	})
+	pending.Push(candidate{from, 0})
+
+	for !pending.IsEmpty() {
+		current := pending.Pop()
+
+		if _, ok := settled[current.node]; ok {
+			continue
+		}
+
+		settled[current.node] = struct{}{}
+
+		if current.node == to {
+			break
+		}
+
+		for _, e := range g.out[current.node] {
+			cost := current.cost + e.Weight
+
+			if known, ok := costs[e.To]; !ok || cost < known {
+				costs[e.To] = cost
+				previous[e.To] = current.node
+				pending.Push(candidate{e.To, cost})
+			}
+		}
+	}
+
+	cost, ok := costs[to]
+	if !ok {
+		return nil, 0, errors.NewNoPathError(from, to)
+	}
+
+	path := slice.Collection[N]{to}
+	for n := to; n != from; {
+		n = previous[n]
+		path = append(path, n)
+	}
+
+	return path.Reverse(), cost, nil
+}
+
+// ConnectedComponents returns the groups of nodes connected to each other, ignoring
+// the direction of edges (i.e. the weakly connected components of directed graphs).
+// Components and their nodes follow the insertion order of the nodes.
+func (g *Graph[N]) ConnectedComponents() []slice.Collection[N] {
+	component := make(map[N]int, len(g.nodes))
+	var components []slice.Collection[N]
+
+	for _, start := range g.nodes {
+		if _, ok := component[start]; ok {
+			continue
+		}
+
+		id := len(components)
+		component[start] = id
+		pending := []N{start}
+
+		for len(pending) > 0 {
+			current := pending[len(pending)-1]
+			pending = pending[:len(pending)-1]
+
+			for _, n := range append(g.Neighbors(current), g.in[current]...) {
+				if _, ok := component[n]; !ok {
+					component[n] = id
+					pending = append(pending, n)
+				}
+			}
+		}
+
+		components = append(components, nil)
+	}
+
+	for _, n := range g.nodes {
+		components[component[n]] = append(components[component[n]], n)
+	}
+
+	return components
+}
@@ -0,0 +1,26 @@
+package graph
+
+import "fmt"
+
+func ExampleGraph_TopologicalSort() {
+	g := New[string](Directed).AddEdge("build", "test").AddEdge("test", "deploy")
+
+	fmt.Println(g.TopologicalSort())
+
+	_, err := g.AddEdge("deploy", "build").TopologicalSort()
+	fmt.Println(err)
+	// Output:
+	// [build test deploy] <nil>
+	// graph has a cycle: [build test deploy build]
+}
+
+func ExampleGraph_ShortestPath() {
+	g := New[string](Undirected).
+		AddWeightedEdge("home", "park", 2).
+		AddWeightedEdge("park", "work", 2).
+		AddWeightedEdge("home", "work", 5)
+
+	fmt.Println(g.ShortestPath("home", "work"))
+	// Output:
+	// [home park work] 4 <nil>
+}
@@ -0,0 +1,29 @@
+package graph
+
+import (
+	"fmt"
+
+	"github.com/thefuga/go-collections/internal"
+)
+
+// String returns the graph formatted with %v.
+func (g *Graph[N]) String() string { return fmt.Sprint(g) }
+
+// Format implements fmt.Formatter, printing every node along with its neighbors, in
+// insertion order. %v prints the nodes in a single line, %+v prints one node per line
+// and %#v prints the graph in Go syntax. The precision limits the number of nodes
+// printed (e.g. %.10v).
+func (g *Graph[N]) Format(s fmt.State, verb rune) {
+	internal.Format(s, verb, g, internal.Formatter{
+		Open:  "{",
+		Close: "}",
+		Keyed: true,
+		Each: func(f func(k, v any) bool) {
+			for _, n := range g.nodes {
+				if !f(n, g.Neighbors(n)) {
+					return
+				}
+			}
+		},
+	})
+}
@@ -0,0 +1,179 @@
+// Package graph provides directed and undirected weighted graphs, with traversals,
+// topological sorting, shortest paths and connected components.
+package graph
+
+import (
+	"github.com/thefuga/go-collections/errors"
+	"github.com/thefuga/go-collections/kv"
+	"github.com/thefuga/go-collections/kv/ordered"
+	"github.com/thefuga/go-collections/slice"
+)
+
+// Kind tells whether the edges of a graph have a direction.
+type Kind int
+
+const (
+	// Directed graphs have edges going from one node to another.
+	Directed Kind = iota
+	// Undirected graphs have edges connecting both nodes both ways.
+	Undirected
+)
+
+// Edge connects From to To with a weight. Unweighted edges weigh 1.
+type Edge[N comparable] struct {
+	From   N
+	To     N
+	Weight float64
+}
+
+// Graph is a graph over comparable node IDs. Nodes and edges are kept in insertion
+// order, so every traversal is deterministic.
+type Graph[N comparable] struct {
+	kind  Kind
+	nodes []N
+	out   map[N][]Edge[N]
+	in    map[N][]N
+}
+
+// New makes an empty graph of the given kind.
+func New[N comparable](kind Kind) *Graph[N] {
+	return &Graph[N]{kind: kind, out: make(map[N][]Edge[N]), in: make(map[N][]N)}
+}
+
+// FromAdjacency makes a graph holding an edge from every key to each of its values.
+// As kv collections are not ordered, neither are the nodes of the graph. See FromOrdered.
+func FromAdjacency[N comparable](adjacency kv.Collection[N, []N], kind Kind) *Graph[N] {
+	g := New[N](kind)
+
+	adjacency.Each(g.addAdjacent)
+
+	return g
+}
+
+// FromOrdered makes a graph holding an edge from every key to each of its values,
+// adding the nodes in the order of the collection.
+func FromOrdered[N comparable](adjacency ordered.Collection[N, []N], kind Kind) *Graph[N] {
+	g := New[N](kind)
+
+	adjacency.Each(g.addAdjacent)
+
+	return g
+}
+
+func (g *Graph[N]) addAdjacent(from N, to []N) {
+	g.AddNode(from)
+
+	for _, n := range to {
+		g.AddEdge(from, n)
+	}
+}
+
+// Kind returns the kind of the graph.
+func (g *Graph[N]) Kind() Kind { return g.kind }
+
+// AddNode adds n to the graph, when it's not already there.
+func (g *Graph[N]) AddNode(n N) *Graph[N] {
+	if !g.HasNode(n) {
+		g.nodes = append(g.nodes, n)
+		g.out[n] = nil
+	}
+
+	return g
+}
+
+// AddEdge adds an edge weighing 1 from from to to. See AddWeightedEdge.
+func (g *Graph[N]) AddEdge(from, to N) *Graph[N] {
+	return g.AddWeightedEdge(from, to, 1)
+}
+
+// AddWeightedEdge adds an edge from from to to, adding missing nodes. On undirected
+// graphs, the edge also goes from to to from. Should the edge exist, its weight is
+// replaced.
+func (g *Graph[N]) AddWeightedEdge(from, to N, weight float64) *Graph[N] {
+	g.AddNode(from).AddNode(to)
+	g.addArc(from, to, weight)
+
+	if g.kind == Undirected && from != to {
+		g.addArc(to, from, weight)
+	}
+
+	return g
+}
+
+func (g *Graph[N]) addArc(from, to N, weight float64) {
+	for i, e := range g.out[from] {
+		if e.To == to {
+			g.out[from][i].Weight = weight
+			return
+		}
+	}
+
+	g.out[from] = append(g.out[from], Edge[N]{From: from, To: to, Weight: weight})
+	g.in[to] = append(g.in[to], from)
+}
+
+// HasNode checks if n is on the graph.
+func (g *Graph[N]) HasNode(n N) bool {
+	_, ok := g.out[n]
+	return ok
+}
+
+// HasEdge checks if there is an edge from from to to.
+func (g *Graph[N]) HasEdge(from, to N) bool {
+	_, err := g.WeightE(from, to)
+	return err == nil
+}
+
+// Weight calls WeightE, omitting the error.
+func (g *Graph[N]) Weight(from, to N) float64 {
+	w, _ := g.WeightE(from, to)
+	return w
+}
+
+// WeightE returns the weight of the edge from from to to. Should there be no such
+// edge, an instance of errors.ValueNotFoundError is returned.
+func (g *Graph[N]) WeightE(from, to N) (float64, error) {
+	for _, e := range g.out[from] {
+		if e.To == to {
+			return e.Weight, nil
+		}
+	}
+
+	return 0, errors.NewValueNotFoundError()
+}
+
+// Count returns the number of nodes on the graph.
+func (g *Graph[N]) Count() int { return len(g.nodes) }
+
+// Nodes returns the nodes of the graph, in insertion order.
+func (g *Graph[N]) Nodes() slice.Collection[N] {
+	return slice.Collection[N](g.nodes).Copy()
+}
+
+// Neighbors returns the nodes reached by the edges leaving n.
+func (g *Graph[N]) Neighbors(n N) slice.Collection[N] {
+	neighbors := make(slice.Collection[N], 0, len(g.out[n]))
+
+	for _, e := range g.out[n] {
+		neighbors = neighbors.Push(e.To)
+	}
+
+	return neighbors
+}
+
+// Edges returns the edges leaving n.
+func (g *Graph[N]) Edges(n N) slice.Collection[Edge[N]] {
+	return slice.Collection[Edge[N]](g.out[n]).Copy()
+}
+
+// ToAdjacency returns the graph as an ordered collection mapping every node to its
+// neighbors.
+func (g *Graph[N]) ToAdjacency() ordered.Collection[N, []N] {
+	adjacency := ordered.CollectMap(make(map[N][]N, len(g.nodes)))
+
+	for _, n := range g.nodes {
+		adjacency.Put(n, g.Neighbors(n))
+	}
+
+	return adjacency
+}
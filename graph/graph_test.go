@@ -0,0 +1,223 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/thefuga/go-collections/kv"
+	"github.com/thefuga/go-collections/kv/ordered"
+	"github.com/thefuga/go-collections/slice"
+)
+
+func dependencies() *Graph[string] {
+	adjacency := ordered.CollectMap(map[string][]string{})
+	adjacency.Put("app", []string{"http", "db"})
+	adjacency.Put("http", []string{"log"})
+	adjacency.Put("db", []string{"log", "driver"})
+	adjacency.Put("docs", nil)
+
+	return FromOrdered(adjacency, Directed)
+}
+
+func TestEdges(t *testing.T) {
+	g := New[string](Undirected).AddWeightedEdge("a", "b", 2).AddEdge("b", "c").AddWeightedEdge("b", "a", 5)
+
+	if !g.HasEdge("b", "a") || g.Weight("a", "b") != 5 || g.HasEdge("a", "c") {
+		t.Error("undirected edges must go both ways")
+	}
+
+	if _, err := g.WeightE("a", "c"); err == nil || err.Error() != "value not found" {
+		t.Errorf("expected a value not found error. got %v", err)
+	}
+
+	if expected := slice.Collect("a", "c"); !reflect.DeepEqual(g.Neighbors("b"), expected) {
+		t.Errorf("expected %v. got %v", expected, g.Neighbors("b"))
+	}
+
+	directed := FromAdjacency(kv.Collection[int, []int]{1: {2}}, Directed)
+	if !directed.HasEdge(1, 2) || directed.HasEdge(2, 1) || directed.Count() != 2 {
+		t.Error("directed edges must go one way")
+	}
+}
+
+func TestBFS(t *testing.T) {
+	var visited []string
+	var depths []int
+
+	dependencies().BFS("app", func(n string, depth int) bool {
+		visited = append(visited, n)
+		depths = append(depths, depth)
+		return true
+	})
+
+	if expected := []string{"app", "http", "db", "log", "driver"}; !reflect.DeepEqual(visited, expected) {
+		t.Errorf("expected %v. got %v", expected, visited)
+	}
+
+	if expected := []int{0, 1, 1, 2, 2}; !reflect.DeepEqual(depths, expected) {
+		t.Errorf("expected %v. got %v", expected, depths)
+	}
+
+	visited = nil
+	dependencies().BFS("app", func(n string, depth int) bool {
+		visited = append(visited, n)
+		return depth == 0
+	})
+
+	if len(visited) != 2 {
+		t.Errorf("expected the traversal to stop. got %v", visited)
+	}
+}
+
+func TestDFS(t *testing.T) {
+	var visited []string
+
+	dependencies().DFS("app", func(n string) bool {
+		visited = append(visited, n)
+		return true
+	})
+
+	if expected := []string{"app", "http", "log", "db", "driver"}; !reflect.DeepEqual(visited, expected) {
+		t.Errorf("expected %v. got %v", expected, visited)
+	}
+
+	visited = nil
+	dependencies().DFS("missing", func(n string) bool {
+		visited = append(visited, n)
+		return n != "http"
+	})
+
+	if visited != nil {
+		t.Errorf("expected missing nodes not to be visited. got %v", visited)
+	}
+}
+
+func TestTopologicalSort(t *testing.T) {
+	sorted, err := dependencies().TopologicalSort()
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if expected := slice.Collect("docs", "app", "db", "driver", "http", "log"); !reflect.DeepEqual(sorted, expected) {
+		t.Errorf("expected %v. got %v", expected, sorted)
+	}
+
+	cyclic := dependencies().AddEdge("log", "app")
+
+	_, err = cyclic.TopologicalSort()
+
+	var cycleErr CycleError[string]
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("expected a cycle error. got %v", err)
+	}
+
+	if expected := []string{"app", "http", "log", "app"}; !reflect.DeepEqual(cycleErr.Cycle, expected) {
+		t.Errorf("expected %v. got %v", expected, cycleErr.Cycle)
+	}
+
+	if err.Error() != "graph has a cycle: [app http log app]" {
+		t.Errorf("unexpected error message %s", err.Error())
+	}
+
+	if _, err := New[int](Directed).AddEdge(1, 1).TopologicalSort(); err == nil {
+		t.Error("expected self loops to be cycles")
+	}
+}
+
+func TestShortestPath(t *testing.T) {
+	g := New[string](Directed).
+		AddWeightedEdge("a", "b", 7).
+		AddWeightedEdge("a", "c", 9).
+		AddWeightedEdge("a", "f", 14).
+		AddWeightedEdge("b", "c", 10).
+		AddWeightedEdge("b", "d", 15).
+		AddWeightedEdge("c", "d", 11).
+		AddWeightedEdge("c", "f", 2).
+		AddWeightedEdge("d", "e", 6).
+		AddWeightedEdge("f", "e", 9).
+		AddNode("z")
+
+	testCases := []struct {
+		description string
+		from, to    string
+		path        slice.Collection[string]
+		cost        float64
+		err         string
+	}{
+		{"classic example", "a", "e", slice.Collect("a", "c", "f", "e"), 20, ""},
+		{"same node", "a", "a", slice.Collect("a"), 0, ""},
+		{"unreachable", "e", "a", nil, 0, "no path from 'e' to 'a'"},
+		{"isolated node", "a", "z", nil, 0, "no path from 'a' to 'z'"},
+		{"missing node", "a", "y", nil, 0, "no path from 'a' to 'y'"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			path, cost, err := g.ShortestPath(tc.from, tc.to)
+
+			if !reflect.DeepEqual(path, tc.path) || cost != tc.cost {
+				t.Errorf("expected %v (%v). got %v (%v)", tc.path, tc.cost, path, cost)
+			}
+
+			if (err == nil) != (tc.err == "") || (err != nil && err.Error() != tc.err) {
+				t.Errorf("expected error to be '%s'. got %v", tc.err, err)
+			}
+		})
+	}
+
+	g.AddWeightedEdge("g", "a", -3)
+
+	for _, tc := range []struct{ description, from, to string }{
+		{"negative weight on the path", "g", "e"},
+		{"negative weight off the path", "a", "e"},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			expected := "negative weight -3 from 'g' to 'a'"
+
+			if path, _, err := g.ShortestPath(tc.from, tc.to); err == nil || err.Error() != expected || path != nil {
+				t.Errorf("expected error to be '%s'. got %v, %v", expected, path, err)
+			}
+		})
+	}
+}
+
+func TestConnectedComponents(t *testing.T) {
+	g := New[int](Directed).AddEdge(1, 2).AddEdge(3, 2).AddEdge(4, 5).AddNode(6)
+
+	expected := []slice.Collection[int]{{1, 2, 3}, {4, 5}, {6}}
+	if components := g.ConnectedComponents(); !reflect.DeepEqual(components, expected) {
+		t.Errorf("expected %v. got %v", expected, components)
+	}
+}
+
+func TestToAdjacency(t *testing.T) {
+	adjacency := dependencies().ToAdjacency()
+
+	if expected := slice.Collect("app", "http", "db", "log", "driver", "docs"); !reflect.DeepEqual(adjacency.Keys(), expected) {
+		t.Errorf("expected %v. got %v", expected, adjacency.Keys())
+	}
+}
+
+func TestFormat(t *testing.T) {
+	g := New[string](Directed).AddEdge("b", "a").AddEdge("b", "c").AddNode("a")
+
+	testCases := []struct {
+		description string
+		format      string
+		expected    string
+	}{
+		{"single line", "%v", "{b:[a c] a:[] c:[]}"},
+		{"truncated", "%.1v", "{b:[a c] ...}"},
+		{"multi line", "%+v", "{\n  b: [\n    a\n    c\n  ]\n  a: []\n  c: []\n}"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if actual := fmt.Sprintf(tc.format, g); actual != tc.expected {
+				t.Errorf("expected %q. got %q", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package immutable
+
+import (
+	"fmt"
+
+	"github.com/thefuga/go-collections/internal"
+)
+
+// String returns the vector formatted with %v.
+func (v Vector[V]) String() string { return fmt.Sprint(v) }
+
+// Format implements fmt.Formatter. %v prints the values in a single line, %+v prints
+// one value per line and %#v prints the vector in Go syntax. The precision limits the
+// number of values printed (e.g. %.10v).
+func (v Vector[V]) Format(s fmt.State, verb rune) {
+	internal.Format(s, verb, v, internal.Formatter{
+		Open:  "[",
+		Close: "]",
+		Each: func(f func(k, v any) bool) {
+			for i, value := range v.ToSlice() {
+				if !f(i, value) {
+					return
+				}
+			}
+		},
+	})
+}
+
+// String returns the map formatted with %v.
+func (m Map[K, V]) String() string { return fmt.Sprint(m) }
+
+// Format implements fmt.Formatter, printing the pairs sorted by key the same way
+// kv.Collection does.
+func (m Map[K, V]) Format(s fmt.State, verb rune) {
+	values := m.ToKV()
+	keys := values.Keys()
+	internal.SortKeys(keys)
+
+	internal.Format(s, verb, m, internal.Formatter{
+		Open:  "map[",
+		Close: "]",
+		Keyed: true,
+		Each: func(f func(k, v any) bool) {
+			for _, k := range keys {
+				if !f(k, values[k]) {
+					return
+				}
+			}
+		},
+	})
+}
@@ -0,0 +1,408 @@
+package immutable
+
+import (
+	"math/bits"
+
+	"github.com/thefuga/go-collections"
+	"github.com/thefuga/go-collections/errors"
+	"github.com/thefuga/go-collections/internal"
+	"github.com/thefuga/go-collections/kv"
+	"github.com/thefuga/go-collections/slice"
+)
+
+const hashBits = 64
+
+// Map is a persistent map implemented as a hash array mapped trie (HAMT). Get, Put
+// and Forget run in O(log32 n), which is effectively constant. It offers the same method
+// names as kv.Collection and, just like it, doesn't guarantee order.
+// See internal.Hash for how keys are hashed.
+type Map[K comparable, V any] struct {
+	count int
+	root  *mapNode[K, V]
+}
+
+// mapNode is either a bitmap indexed node, holding up to 32 entries, or a collision
+// node, used once the hash bits are exhausted, holding every entry with the same hash.
+type mapNode[K comparable, V any] struct {
+	bitmap  uint32
+	entries []mapEntry[K, V]
+}
+
+// mapEntry holds either a key-value pair or a sub node.
+type mapEntry[K comparable, V any] struct {
+	hash  uint64
+	key   K
+	value V
+	node  *mapNode[K, V]
+}
+
+// CollectMap makes a new Map holding the key-value pairs of the given map.
+func CollectMap[K comparable, V any](items map[K]V) Map[K, V] {
+	var m Map[K, V]
+
+	for k, v := range items {
+		m = m.Put(k, v)
+	}
+
+	return m
+}
+
+// FromKV makes a new Map holding the key-value pairs of the kv collection.
+func FromKV[K comparable, V any](c kv.Collection[K, V]) Map[K, V] {
+	return CollectMap(c)
+}
+
+// Count returns the number of key-value pairs stored on the map.
+func (m Map[K, V]) Count() int { return m.count }
+
+// IsEmpty checks if the map is empty.
+func (m Map[K, V]) IsEmpty() bool { return m.count == 0 }
+
+// Has checks if k is present on the map.
+func (m Map[K, V]) Has(k K) bool {
+	_, err := m.GetE(k)
+	return err == nil
+}
+
+// Get calls GetE, omitting the error.
+func (m Map[K, V]) Get(k K) V {
+	v, _ := m.GetE(k)
+	return v
+}
+
+// GetE returns the value associated to k. Should k not exist, a zeroed V and an
+// instance of errors.KeyNotFoundError are returned.
+func (m Map[K, V]) GetE(k K) (V, error) {
+	hash := internal.Hash(k)
+
+	for node, shift := m.root, uint(0); node != nil; shift += shiftBits {
+		if shift >= hashBits {
+			for _, entry := range node.entries {
+				if entry.key == k {
+					return entry.value, nil
+				}
+			}
+			break
+		}
+
+		bit := bitFor(hash, shift)
+		if node.bitmap&bit == 0 {
+			break
+		}
+
+		entry := node.entries[node.index(bit)]
+		if entry.node == nil {
+			if entry.key == k {
+				return entry.value, nil
+			}
+			break
+		}
+
+		node = entry.node
+	}
+
+	return *new(V), errors.NewKeyNotFoundError(k)
+}
+
+// Put returns a new map with v associated to k. Should k already exist, its value
+// is replaced on the new map.
+func (m Map[K, V]) Put(k K, v V) Map[K, V] {
+	root := m.root
+	if root == nil {
+		root = &mapNode[K, V]{}
+	}
+
+	var added bool
+	m.root, added = root.put(mapEntry[K, V]{hash: internal.Hash(k), key: k, value: v}, 0)
+
+	if added {
+		m.count++
+	}
+
+	return m
+}
+
+// Forget returns a new map without k.
+func (m Map[K, V]) Forget(k K) Map[K, V] {
+	forgotten, _ := m.ForgetE(k)
+	return forgotten
+}
+
+// ForgetE returns a new map without k. Should k not exist, the map is returned
+// unchanged along with an instance of errors.KeyNotFoundError.
+func (m Map[K, V]) ForgetE(k K) (Map[K, V], error) {
+	if m.root == nil {
+		return m, errors.NewKeyNotFoundError(k)
+	}
+
+	root, removed := m.root.forget(k, internal.Hash(k), 0)
+	if !removed {
+		return m, errors.NewKeyNotFoundError(k)
+	}
+
+	m.root = root
+	m.count--
+
+	return m, nil
+}
+
+// Each calls f with every key-value pair of the map. Order is not guaranteed, but is
+// the same for equal versions of the map.
+func (m Map[K, V]) Each(f func(k K, v V)) Map[K, V] {
+	if m.root != nil {
+		m.root.each(f)
+	}
+
+	return m
+}
+
+// Keys returns a new slice.Collection containing all keys of the map.
+func (m Map[K, V]) Keys() slice.Collection[K] {
+	keys := make(slice.Collection[K], 0, m.count)
+
+	m.Each(func(k K, _ V) {
+		keys = keys.Push(k)
+	})
+
+	return keys
+}
+
+// Values returns a new slice.Collection containing all values of the map.
+func (m Map[K, V]) Values() slice.Collection[V] {
+	values := make(slice.Collection[V], 0, m.count)
+
+	m.Each(func(_ K, v V) {
+		values = values.Push(v)
+	})
+
+	return values
+}
+
+// ToSlice is an alias to Map.Values.
+func (m Map[K, V]) ToSlice() []V { return m.Values() }
+
+// ToKV makes a new kv.Collection holding the key-value pairs of the map.
+func (m Map[K, V]) ToKV() kv.Collection[K, V] {
+	c := make(kv.Collection[K, V], m.count)
+
+	m.Each(func(k K, v V) {
+		c.Put(k, v)
+	})
+
+	return c
+}
+
+// Map returns a new map holding the values returned by f.
+func (m Map[K, V]) Map(f func(k K, v V) V) Map[K, V] {
+	mapped := m
+
+	m.Each(func(k K, v V) {
+		mapped = mapped.Put(k, f(k, v))
+	})
+
+	return mapped
+}
+
+// Filter returns a new map holding only the key-value pairs matched by f.
+func (m Map[K, V]) Filter(f func(k K, v V) bool) Map[K, V] {
+	filtered := m
+
+	m.Each(func(k K, v V) {
+		if !f(k, v) {
+			filtered = filtered.Forget(k)
+		}
+	})
+
+	return filtered
+}
+
+// Reject returns a new map holding only the key-value pairs not matched by f.
+func (m Map[K, V]) Reject(f func(k K, v V) bool) Map[K, V] {
+	return m.Filter(func(k K, v V) bool {
+		return !f(k, v)
+	})
+}
+
+// Merge returns a new map holding the key-value pairs from both maps. Should two
+// keys equal, the value from other is used.
+func (m Map[K, V]) Merge(other Map[K, V]) Map[K, V] {
+	merged := m
+
+	other.Each(func(k K, v V) {
+		merged = merged.Put(k, v)
+	})
+
+	return merged
+}
+
+// Contains checks if any values on the map match f. Values are indexed in the order
+// of Each, stopping at the first match.
+func (m Map[K, V]) Contains(f collections.Matcher[int, V]) bool {
+	if m.root == nil {
+		return false
+	}
+
+	i := 0
+
+	return !m.root.walk(func(_ K, v V) bool {
+		matched := f(i, v)
+		i++
+
+		return !matched
+	})
+}
+
+// Tap passes the map to f and returns the map.
+func (m Map[K, V]) Tap(f func(Map[K, V])) Map[K, V] {
+	f(m)
+	return m
+}
+
+func bitFor(hash uint64, shift uint) uint32 {
+	return 1 << ((hash >> shift) & mask)
+}
+
+func (n *mapNode[K, V]) index(bit uint32) int {
+	return bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+func (n *mapNode[K, V]) put(entry mapEntry[K, V], shift uint) (*mapNode[K, V], bool) {
+	if shift >= hashBits {
+		return n.putCollision(entry)
+	}
+
+	bit := bitFor(entry.hash, shift)
+	i := n.index(bit)
+	copied := &mapNode[K, V]{bitmap: n.bitmap | bit}
+
+	if n.bitmap&bit == 0 {
+		copied.entries = make([]mapEntry[K, V], len(n.entries)+1)
+		copy(copied.entries, n.entries[:i])
+		copied.entries[i] = entry
+		copy(copied.entries[i+1:], n.entries[i:])
+
+		return copied, true
+	}
+
+	copied.entries = make([]mapEntry[K, V], len(n.entries))
+	copy(copied.entries, n.entries)
+
+	current := n.entries[i]
+	added := true
+
+	switch {
+	case current.node != nil:
+		copied.entries[i].node, added = current.node.put(entry, shift+shiftBits)
+	case current.key == entry.key:
+		copied.entries[i] = entry
+		added = false
+	default:
+		sub := &mapNode[K, V]{}
+		sub, _ = sub.put(current, shift+shiftBits)
+		sub, _ = sub.put(entry, shift+shiftBits)
+		copied.entries[i] = mapEntry[K, V]{node: sub}
+	}
+
+	return copied, added
+}
+
+func (n *mapNode[K, V]) putCollision(entry mapEntry[K, V]) (*mapNode[K, V], bool) {
+	copied := &mapNode[K, V]{entries: make([]mapEntry[K, V], len(n.entries), len(n.entries)+1)}
+	copy(copied.entries, n.entries)
+
+	for i, current := range copied.entries {
+		if current.key == entry.key {
+			copied.entries[i] = entry
+			return copied, false
+		}
+	}
+
+	copied.entries = append(copied.entries, entry)
+
+	return copied, true
+}
+
+// forget returns the node without k. A nil node is returned once the node is empty.
+func (n *mapNode[K, V]) forget(k K, hash uint64, shift uint) (*mapNode[K, V], bool) {
+	if shift >= hashBits {
+		for i, current := range n.entries {
+			if current.key == k {
+				return n.without(i, 0), true
+			}
+		}
+
+		return n, false
+	}
+
+	bit := bitFor(hash, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+
+	i := n.index(bit)
+	current := n.entries[i]
+
+	if current.node == nil {
+		if current.key != k {
+			return n, false
+		}
+
+		return n.without(i, bit), true
+	}
+
+	sub, removed := current.node.forget(k, hash, shift+shiftBits)
+	if !removed {
+		return n, false
+	}
+
+	if sub == nil {
+		return n.without(i, bit), true
+	}
+
+	copied := &mapNode[K, V]{bitmap: n.bitmap, entries: make([]mapEntry[K, V], len(n.entries))}
+	copy(copied.entries, n.entries)
+
+	if len(sub.entries) == 1 && sub.entries[0].node == nil {
+		// A sub node holding a single pair is collapsed into its parent.
+		copied.entries[i] = sub.entries[0]
+	} else {
+		copied.entries[i].node = sub
+	}
+
+	return copied, true
+}
+
+func (n *mapNode[K, V]) without(i int, bit uint32) *mapNode[K, V] {
+	if len(n.entries) == 1 {
+		return nil
+	}
+
+	copied := &mapNode[K, V]{bitmap: n.bitmap &^ bit, entries: make([]mapEntry[K, V], 0, len(n.entries)-1)}
+	copied.entries = append(copied.entries, n.entries[:i]...)
+	copied.entries = append(copied.entries, n.entries[i+1:]...)
+
+	return copied
+}
+
+func (n *mapNode[K, V]) each(f func(k K, v V)) {
+	n.walk(func(k K, v V) bool {
+		f(k, v)
+		return true
+	})
+}
+
+// walk calls f with every key-value pair under n until f returns false, in which case
+// walk returns false as well.
+func (n *mapNode[K, V]) walk(f func(k K, v V) bool) bool {
+	for _, entry := range n.entries {
+		if entry.node != nil {
+			if !entry.node.walk(f) {
+				return false
+			}
+		} else if !f(entry.key, entry.value) {
+			return false
+		}
+	}
+
+	return true
+}
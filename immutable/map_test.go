@@ -0,0 +1,266 @@
+package immutable
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/thefuga/go-collections/kv"
+)
+
+func TestMapPutAndGet(t *testing.T) {
+	var m Map[string, int]
+	count := 20000
+
+	for i := 0; i < count; i++ {
+		m = m.Put(fmt.Sprint(i), i)
+	}
+
+	if m.Count() != count {
+		t.Fatalf("expected count to be %d. got %d", count, m.Count())
+	}
+
+	for i := 0; i < count; i++ {
+		if v, err := m.GetE(fmt.Sprint(i)); err != nil || v != i {
+			t.Fatalf("expected %d to be found. got %d (%v)", i, v, err)
+		}
+	}
+
+	if _, err := m.GetE("missing"); err == nil || err.Error() != "key 'missing' not found" {
+		t.Errorf("expected a key not found error. got %v", err)
+	}
+}
+
+func TestMapPutOverrides(t *testing.T) {
+	m := CollectMap(map[string]int{"a": 1})
+	overridden := m.Put("a", 2)
+
+	if overridden.Count() != 1 || overridden.Get("a") != 2 {
+		t.Errorf("expected a to be overridden. got %v", overridden.ToKV())
+	}
+
+	if m.Get("a") != 1 {
+		t.Error("the original map must be left untouched")
+	}
+}
+
+func TestMapForget(t *testing.T) {
+	var m Map[int, int]
+	count := 5000
+
+	for i := 0; i < count; i++ {
+		m = m.Put(i, i)
+	}
+
+	full := m
+
+	for i := 0; i < count; i += 2 {
+		m = m.Forget(i)
+	}
+
+	if m.Count() != count/2 {
+		t.Fatalf("expected count to be %d. got %d", count/2, m.Count())
+	}
+
+	for i := 0; i < count; i++ {
+		if m.Has(i) == (i%2 == 0) {
+			t.Fatalf("unexpected presence of %d", i)
+		}
+	}
+
+	if full.Count() != count || !full.Has(0) {
+		t.Error("the original map must be left untouched")
+	}
+
+	if _, err := m.ForgetE(0); err == nil {
+		t.Error("forgetting a missing key must return an error")
+	}
+
+	for i := 1; i < count; i += 2 {
+		m = m.Forget(i)
+	}
+
+	if !m.IsEmpty() {
+		t.Errorf("expected the map to be empty. got %d elements", m.Count())
+	}
+}
+
+func TestMapHashCollisions(t *testing.T) {
+	root := &mapNode[string, int]{}
+	root, _ = root.put(mapEntry[string, int]{hash: 42, key: "a", value: 1}, 0)
+	root, _ = root.put(mapEntry[string, int]{hash: 42, key: "b", value: 2}, 0)
+	root, added := root.put(mapEntry[string, int]{hash: 42, key: "b", value: 3}, 0)
+
+	if added {
+		t.Error("replacing a colliding key must not add a new entry")
+	}
+
+	values := map[string]int{}
+	root.each(func(k string, v int) { values[k] = v })
+
+	if expected := map[string]int{"a": 1, "b": 3}; !reflect.DeepEqual(values, expected) {
+		t.Errorf("expected %v. got %v", expected, values)
+	}
+
+	root, removed := root.forget("a", 42, 0)
+	if !removed {
+		t.Fatal("expected a to be removed")
+	}
+
+	values = map[string]int{}
+	root.each(func(k string, v int) { values[k] = v })
+
+	if expected := map[string]int{"b": 3}; !reflect.DeepEqual(values, expected) {
+		t.Errorf("expected %v. got %v", expected, values)
+	}
+}
+
+func TestMapConversions(t *testing.T) {
+	c := kv.CollectMap(map[string]int{"a": 1, "b": 2, "c": 3})
+	m := FromKV(c)
+
+	if !reflect.DeepEqual(m.ToKV(), c) {
+		t.Errorf("expected %v. got %v", c, m.ToKV())
+	}
+
+	keys := m.Keys()
+	sort.Strings(keys)
+
+	if expected := []string{"a", "b", "c"}; !reflect.DeepEqual([]string(keys), expected) {
+		t.Errorf("expected keys to be %v. got %v", expected, keys)
+	}
+}
+
+func TestMapFilterMapMerge(t *testing.T) {
+	m := CollectMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	filtered := m.Filter(func(_ string, v int) bool { return v > 1 })
+	if expected := kv.CollectMap(map[string]int{"b": 2, "c": 3}); !reflect.DeepEqual(filtered.ToKV(), expected) {
+		t.Errorf("expected %v. got %v", expected, filtered.ToKV())
+	}
+
+	rejected := m.Reject(func(_ string, v int) bool { return v > 1 })
+	if expected := kv.CollectMap(map[string]int{"a": 1}); !reflect.DeepEqual(rejected.ToKV(), expected) {
+		t.Errorf("expected %v. got %v", expected, rejected.ToKV())
+	}
+
+	mapped := m.Map(func(_ string, v int) int { return v * 10 })
+	if expected := kv.CollectMap(map[string]int{"a": 10, "b": 20, "c": 30}); !reflect.DeepEqual(mapped.ToKV(), expected) {
+		t.Errorf("expected %v. got %v", expected, mapped.ToKV())
+	}
+
+	merged := m.Merge(CollectMap(map[string]int{"c": 30, "d": 40}))
+	if expected := kv.CollectMap(map[string]int{"a": 1, "b": 2, "c": 30, "d": 40}); !reflect.DeepEqual(merged.ToKV(), expected) {
+		t.Errorf("expected %v. got %v", expected, merged.ToKV())
+	}
+
+	if m.Count() != 3 || m.Get("c") != 3 {
+		t.Error("the original map must be left untouched")
+	}
+}
+
+func TestMapContains(t *testing.T) {
+	var m Map[int, int]
+	for i := 0; i < 1000; i++ {
+		m = m.Put(i, i)
+	}
+
+	visited := 0
+
+	if !m.Contains(func(_ int, v int) bool {
+		visited++
+		return v%2 == 0
+	}) {
+		t.Error("expected the map to contain an even value")
+	}
+
+	expected := 0
+	for _, v := range m.Values() {
+		expected++
+		if v%2 == 0 {
+			break
+		}
+	}
+
+	if visited != expected {
+		t.Errorf("expected %d visited values. got %d", expected, visited)
+	}
+
+	if m.Contains(func(_ int, v int) bool { return v < 0 }) {
+		t.Error("expected the map not to contain negative values")
+	}
+
+	if (Map[int, int]{}).Contains(func(int, int) bool { return true }) {
+		t.Error("expected the empty map not to contain any value")
+	}
+}
+
+func TestMapWithPointerKeys(t *testing.T) {
+	type node struct{ value int }
+	a, b := &node{1}, &node{1}
+
+	m := CollectMap(map[*node]string{a: "a", b: "b"})
+	a.value = 2
+
+	if m.Get(a) != "a" || m.Get(b) != "b" {
+		t.Error("pointer keys must be hashed by address")
+	}
+}
+
+func TestMapWithStructKeys(t *testing.T) {
+	type point struct {
+		X, Y float64
+		_    int
+		Tag  string
+	}
+
+	negativeZero := math.Copysign(0, -1)
+	m := Map[point, string]{}.Put(point{X: 0, Y: 1, Tag: "a"}, "origin")
+
+	testCases := []struct {
+		description string
+		key         point
+		found       bool
+	}{
+		{"equal key", point{X: 0, Y: 1, Tag: "a"}, true},
+		{"negative zero", point{X: negativeZero, Y: 1, Tag: "a"}, true},
+		{"different interface value", point{X: 0, Y: 1, Tag: "b"}, false},
+		{"different field", point{X: 1, Y: 1, Tag: "a"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if found := m.Has(tc.key); found != tc.found {
+				t.Errorf("expected %v. got %v", tc.found, found)
+			}
+		})
+	}
+
+	arrays := Map[[2]float64, int]{}.Put([2]float64{negativeZero, 1}, 1)
+	if !arrays.Has([2]float64{0, 1}) {
+		t.Error("expected equal arrays to share a hash")
+	}
+}
+
+func TestFormat(t *testing.T) {
+	testCases := []struct {
+		description string
+		format      string
+		collection  any
+		expected    string
+	}{
+		{"vector", "%v", Collect(1, 2, 3), "[1 2 3]"},
+		{"truncated vector", "%.1v", Collect(1, 2, 3), "[1 ...]"},
+		{"map", "%v", FromKV(kv.Collection[string, int]{"b": 2, "a": 1}), "map[a:1 b:2]"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if actual := fmt.Sprintf(tc.format, tc.collection); actual != tc.expected {
+				t.Errorf("expected %q. got %q", tc.expected, actual)
+			}
+		})
+	}
+}
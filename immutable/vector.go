@@ -0,0 +1,402 @@
+// Package immutable provides persistent collections. Every method that would modify
+// a collection returns a new version of it instead, leaving the receiver untouched.
+// New versions share most of their structure with the previous ones, so modifying a
+// collection is cheap both in time and memory.
+// The zero value of every type is an empty collection ready to use.
+package immutable
+
+import (
+	"reflect"
+
+	"github.com/thefuga/go-collections"
+	"github.com/thefuga/go-collections/errors"
+	"github.com/thefuga/go-collections/slice"
+)
+
+const (
+	shiftBits = 5
+	width     = 1 << shiftBits
+	mask      = width - 1
+)
+
+// Vector is a persistent slice implemented as a bit-partitioned trie with a branching
+// factor of 32. Get, Set, Push and Pop run in O(log32 n), which is effectively constant.
+// It offers the same method names as slice.Collection.
+type Vector[V any] struct {
+	count int
+	shift uint
+	root  *vectorNode[V]
+	tail  []V
+}
+
+type vectorNode[V any] struct {
+	children []*vectorNode[V]
+	values   []V
+}
+
+// Collect makes a new Vector holding the given values.
+func Collect[V any](values ...V) Vector[V] {
+	var vector Vector[V]
+
+	for _, v := range values {
+		vector = vector.Push(v)
+	}
+
+	return vector
+}
+
+// FromSlice makes a new Vector holding the values of the slice collection.
+func FromSlice[V any](c slice.Collection[V]) Vector[V] {
+	return Collect(c...)
+}
+
+// Count returns the number of elements stored on the vector.
+func (v Vector[V]) Count() int { return v.count }
+
+// IsEmpty checks if the vector is empty.
+func (v Vector[V]) IsEmpty() bool { return v.count == 0 }
+
+// Get calls GetE, omitting the error.
+func (v Vector[V]) Get(i int) V {
+	value, _ := v.GetE(i)
+	return value
+}
+
+// GetE returns the element at the index i. Should i be out of bounds, a zeroed V
+// and an errors.ValueNotFound error are returned, just like collections.GetE.
+func (v Vector[V]) GetE(i int) (V, error) {
+	if v.count == 0 {
+		return *new(V), errors.NewEmptyCollectionError(errors.NewValueNotFoundError())
+	}
+
+	if i < 0 || i >= v.count {
+		return *new(V), errors.NewIndexOutOfBoundsError(errors.NewValueNotFoundError())
+	}
+
+	return v.valuesFor(i)[i&mask], nil
+}
+
+// First calls FirstE, omitting the error.
+func (v Vector[V]) First() V { return v.Get(0) }
+
+// FirstE returns the first element of the vector.
+func (v Vector[V]) FirstE() (V, error) { return v.GetE(0) }
+
+// Last calls LastE, omitting the error.
+func (v Vector[V]) Last() V { return v.Get(v.count - 1) }
+
+// LastE returns the last element of the vector.
+func (v Vector[V]) LastE() (V, error) { return v.GetE(v.count - 1) }
+
+// Push returns a new vector with value appended to it.
+func (v Vector[V]) Push(value V) Vector[V] {
+	if v.count-v.tailOffset() < width {
+		tail := make([]V, len(v.tail), len(v.tail)+1)
+		copy(tail, v.tail)
+
+		v.tail = append(tail, value)
+		v.count++
+
+		return v
+	}
+
+	tailNode := &vectorNode[V]{values: v.tail}
+
+	if v.root == nil {
+		v.root = &vectorNode[V]{}
+		v.shift = shiftBits
+	}
+
+	if (v.count >> shiftBits) > (1 << v.shift) {
+		v.root = &vectorNode[V]{children: []*vectorNode[V]{v.root, newPath(v.shift, tailNode)}}
+		v.shift += shiftBits
+	} else {
+		v.root = v.pushTail(v.shift, v.root, tailNode)
+	}
+
+	v.tail = []V{value}
+	v.count++
+
+	return v
+}
+
+// Set returns a new vector with the element at the index i replaced by value. Should i
+// be out of bounds, the vector is returned unchanged. See SetE.
+func (v Vector[V]) Set(i int, value V) Vector[V] {
+	set, _ := v.SetE(i, value)
+	return set
+}
+
+// SetE returns a new vector with the element at the index i replaced by value. Should i
+// be out of bounds, an errors.IndexOutOfBoundsError is returned.
+func (v Vector[V]) SetE(i int, value V) (Vector[V], error) {
+	if i < 0 || i >= v.count {
+		return v, errors.NewIndexOutOfBoundsError()
+	}
+
+	if i >= v.tailOffset() {
+		tail := make([]V, len(v.tail))
+		copy(tail, v.tail)
+		tail[i&mask] = value
+		v.tail = tail
+
+		return v, nil
+	}
+
+	v.root = v.set(v.shift, v.root, i, value)
+
+	return v, nil
+}
+
+// Put returns a new vector with value inserted at the index i, shifting the following
+// elements, just like slice.Collection.Put. Unlike the other methods, it runs in O(n).
+func (v Vector[V]) Put(i int, value V) Vector[V] {
+	return FromSlice(slice.Collection[V](v.ToSlice()).Put(i, value))
+}
+
+// Pop calls PopE, omitting the error.
+func (v Vector[V]) Pop() (Vector[V], V) {
+	popped, value, _ := v.PopE()
+	return popped, value
+}
+
+// PopE returns a new vector without its last element, and the removed element. Should
+// the vector be empty, an errors.EmptyCollectionError is returned.
+func (v Vector[V]) PopE() (Vector[V], V, error) {
+	last, err := v.LastE()
+	if err != nil {
+		return v, last, err
+	}
+
+	if v.count == 1 {
+		return Vector[V]{}, last, nil
+	}
+
+	if v.count-v.tailOffset() > 1 {
+		v.tail = v.tail[: len(v.tail)-1 : len(v.tail)-1]
+		v.count--
+
+		return v, last, nil
+	}
+
+	v.tail = v.valuesFor(v.count - 2)
+
+	root := v.popTail(v.shift, v.root)
+	if root == nil {
+		root = &vectorNode[V]{}
+	}
+
+	if v.shift > shiftBits && len(root.children) == 1 {
+		root = root.children[0]
+		v.shift -= shiftBits
+	}
+
+	v.root = root
+	v.count--
+
+	return v, last, nil
+}
+
+// Each calls f with every element of the vector, in order.
+func (v Vector[V]) Each(f func(i int, value V)) Vector[V] {
+	for i := 0; i < v.count; i += width {
+		values := v.valuesFor(i)
+
+		for j, value := range values {
+			f(i+j, value)
+		}
+	}
+
+	return v
+}
+
+// Map returns a new vector holding the values returned by f.
+func (v Vector[V]) Map(f func(i int, value V) V) Vector[V] {
+	var mapped Vector[V]
+
+	v.Each(func(i int, value V) {
+		mapped = mapped.Push(f(i, value))
+	})
+
+	return mapped
+}
+
+// Filter returns a new vector holding only the elements matched by f.
+func (v Vector[V]) Filter(f func(i int, value V) bool) Vector[V] {
+	var filtered Vector[V]
+
+	v.Each(func(i int, value V) {
+		if f(i, value) {
+			filtered = filtered.Push(value)
+		}
+	})
+
+	return filtered
+}
+
+// Contains checks if the vector holds at least one value matching the given matcher.
+func (v Vector[V]) Contains(matcher collections.Matcher[int, V]) bool {
+	return v.index(matcher) >= 0
+}
+
+// Search uses SearchE, omitting the error.
+func (v Vector[V]) Search(value V) int {
+	i, _ := v.SearchE(value)
+	return i
+}
+
+// SearchE searches for value in the vector, comparing values with reflect.DeepEqual.
+// Should the value not be found, -1 and an instance of errors.ValueNotFoundError are
+// returned. See collections.SearchE.
+func (v Vector[V]) SearchE(value V) (int, error) {
+	i := v.index(func(_ int, current V) bool {
+		return reflect.DeepEqual(current, value)
+	})
+
+	if i < 0 {
+		return i, errors.NewValueNotFoundError()
+	}
+
+	return i, nil
+}
+
+// Tap passes the vector to f and returns the vector.
+func (v Vector[V]) Tap(f func(Vector[V])) Vector[V] {
+	f(v)
+	return v
+}
+
+// ToSlice makes a new slice holding all elements of the vector, in order.
+func (v Vector[V]) ToSlice() []V {
+	result := make([]V, 0, v.count)
+
+	v.Each(func(_ int, value V) {
+		result = append(result, value)
+	})
+
+	return result
+}
+
+// ToSliceCollection returns ToSlice as a slice.Collection.
+func (v Vector[V]) ToSliceCollection() slice.Collection[V] { return v.ToSlice() }
+
+// index returns the index of the first element matched by matcher, walking the trie
+// leaf by leaf, or -1 if none matches.
+func (v Vector[V]) index(matcher collections.Matcher[int, V]) int {
+	for i := 0; i < v.count; i += width {
+		for j, value := range v.valuesFor(i) {
+			if matcher(i+j, value) {
+				return i + j
+			}
+		}
+	}
+
+	return -1
+}
+
+func (v Vector[V]) tailOffset() int {
+	if v.count < width {
+		return 0
+	}
+
+	return ((v.count - 1) >> shiftBits) << shiftBits
+}
+
+// valuesFor returns the leaf (or the tail) holding the element at index i.
+func (v Vector[V]) valuesFor(i int) []V {
+	if i >= v.tailOffset() {
+		return v.tail
+	}
+
+	node := v.root
+	for level := v.shift; level > 0; level -= shiftBits {
+		node = node.children[(i>>level)&mask]
+	}
+
+	return node.values
+}
+
+func (v Vector[V]) pushTail(level uint, parent, tailNode *vectorNode[V]) *vectorNode[V] {
+	if parent == nil {
+		parent = &vectorNode[V]{}
+	}
+
+	subIndex := ((v.count - 1) >> level) & mask
+	children := make([]*vectorNode[V], len(parent.children), subIndex+1)
+	copy(children, parent.children)
+
+	var inserted *vectorNode[V]
+	if level == shiftBits {
+		inserted = tailNode
+	} else if subIndex < len(children) {
+		inserted = v.pushTail(level-shiftBits, children[subIndex], tailNode)
+	} else {
+		inserted = newPath(level-shiftBits, tailNode)
+	}
+
+	if subIndex < len(children) {
+		children[subIndex] = inserted
+	} else {
+		children = append(children, inserted)
+	}
+
+	return &vectorNode[V]{children: children}
+}
+
+func (v Vector[V]) popTail(level uint, node *vectorNode[V]) *vectorNode[V] {
+	subIndex := ((v.count - 2) >> level) & mask
+
+	if level > shiftBits {
+		child := v.popTail(level-shiftBits, node.children[subIndex])
+		if child == nil && subIndex == 0 {
+			return nil
+		}
+
+		children := make([]*vectorNode[V], subIndex+1)
+		copy(children, node.children)
+
+		if child == nil {
+			children = children[:subIndex]
+		} else {
+			children[subIndex] = child
+		}
+
+		return &vectorNode[V]{children: children}
+	}
+
+	if subIndex == 0 {
+		return nil
+	}
+
+	children := make([]*vectorNode[V], subIndex)
+	copy(children, node.children)
+
+	return &vectorNode[V]{children: children}
+}
+
+func (v Vector[V]) set(level uint, node *vectorNode[V], i int, value V) *vectorNode[V] {
+	copied := &vectorNode[V]{}
+
+	if level == 0 {
+		copied.values = make([]V, len(node.values))
+		copy(copied.values, node.values)
+		copied.values[i&mask] = value
+
+		return copied
+	}
+
+	subIndex := (i >> level) & mask
+	copied.children = make([]*vectorNode[V], len(node.children))
+	copy(copied.children, node.children)
+	copied.children[subIndex] = v.set(level-shiftBits, node.children[subIndex], i, value)
+
+	return copied
+}
+
+func newPath[V any](level uint, node *vectorNode[V]) *vectorNode[V] {
+	if level == 0 {
+		return node
+	}
+
+	return &vectorNode[V]{children: []*vectorNode[V]{newPath(level-shiftBits, node)}}
+}
@@ -0,0 +1,192 @@
+package immutable
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thefuga/go-collections"
+	"github.com/thefuga/go-collections/slice"
+)
+
+func TestVectorPushAndGet(t *testing.T) {
+	for _, count := range []int{0, 1, 31, 32, 33, 1024, 1056, 1057, 40000} {
+		var vector Vector[int]
+		for i := 0; i < count; i++ {
+			vector = vector.Push(i)
+		}
+
+		if vector.Count() != count {
+			t.Fatalf("expected count to be %d. got %d", count, vector.Count())
+		}
+
+		for i := 0; i < count; i++ {
+			if v := vector.Get(i); v != i {
+				t.Fatalf("expected element %d to be %d. got %d", i, i, v)
+			}
+		}
+	}
+}
+
+func TestVectorGetE(t *testing.T) {
+	testCases := []struct {
+		description string
+		vector      Vector[int]
+		i           int
+		err         string
+	}{
+		{"empty vector", Vector[int]{}, 0, "value not found: empty collection"},
+		{"negative index", Collect(1, 2), -1, "value not found: index out of bounds"},
+		{"index out of bounds", Collect(1, 2), 2, "value not found: index out of bounds"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if _, err := tc.vector.GetE(tc.i); err == nil || err.Error() != tc.err {
+				t.Errorf("expected error to be '%s'. got '%v'", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestVectorIsPersistent(t *testing.T) {
+	base := FromSlice(slice.Collection[int](sequence(100)))
+
+	pushed := base.Push(100)
+	set := base.Set(10, -10)
+	popped, last := base.Pop()
+
+	if base.Count() != 100 || base.Get(10) != 10 || base.Last() != 99 {
+		t.Error("the original vector must be left untouched")
+	}
+
+	if pushed.Count() != 101 || pushed.Last() != 100 {
+		t.Errorf("expected pushed vector to end with 100. got %v", pushed.Last())
+	}
+
+	if set.Get(10) != -10 {
+		t.Errorf("expected element 10 to be set to -10. got %v", set.Get(10))
+	}
+
+	if popped.Count() != 99 || last != 99 {
+		t.Errorf("expected 99 to be popped. got %v", last)
+	}
+}
+
+func TestVectorPop(t *testing.T) {
+	count := 40000
+	vector := FromSlice(slice.Collection[int](sequence(count)))
+
+	for i := count - 1; i >= 0; i-- {
+		var v int
+		vector, v = vector.Pop()
+
+		if v != i {
+			t.Fatalf("expected popped value to be %d. got %d", i, v)
+		}
+
+		if vector.Count() != i {
+			t.Fatalf("expected count to be %d. got %d", i, vector.Count())
+		}
+
+		if i > 0 && vector.Last() != i-1 {
+			t.Fatalf("expected last value to be %d. got %d", i-1, vector.Last())
+		}
+	}
+
+	if _, _, err := vector.PopE(); err == nil {
+		t.Error("popping an empty vector must return an error")
+	}
+
+	if vector = vector.Push(1); vector.Get(0) != 1 {
+		t.Error("expected to be able to push after popping every element")
+	}
+}
+
+func TestVectorSet(t *testing.T) {
+	count := 2000
+	vector := FromSlice(slice.Collection[int](sequence(count)))
+
+	for i := 0; i < count; i++ {
+		vector = vector.Set(i, i*2)
+	}
+
+	if expected := slice.Collection[int](sequence(count)).Map(func(_ int, v int) int {
+		return v * 2
+	}); !reflect.DeepEqual(vector.ToSliceCollection(), expected) {
+		t.Error("expected every value to be doubled")
+	}
+
+	if _, err := vector.SetE(count, 0); err == nil {
+		t.Error("setting an out of bounds index must return an error")
+	}
+}
+
+func TestVectorPut(t *testing.T) {
+	vector := Collect(1, 2, 4)
+	expected := []int{1, 2, 3, 4}
+
+	if put := vector.Put(2, 3); !reflect.DeepEqual(put.ToSlice(), expected) {
+		t.Errorf("expected %v. got %v", expected, put.ToSlice())
+	}
+}
+
+func TestVectorMapFilterContains(t *testing.T) {
+	vector := Collect(1, 2, 3, 4)
+
+	if mapped := vector.Map(func(_ int, v int) int { return v * 10 }); !reflect.DeepEqual(
+		mapped.ToSlice(), []int{10, 20, 30, 40},
+	) {
+		t.Errorf("unexpected mapped values %v", mapped.ToSlice())
+	}
+
+	if filtered := vector.Filter(func(_ int, v int) bool { return v%2 == 0 }); !reflect.DeepEqual(
+		filtered.ToSlice(), []int{2, 4},
+	) {
+		t.Errorf("unexpected filtered values %v", filtered.ToSlice())
+	}
+
+	if !vector.Contains(collections.ValueEquals[int](3)) {
+		t.Error("expected the vector to contain 3")
+	}
+
+	if i := vector.Search(4); i != 3 {
+		t.Errorf("expected 4 to be found at 3. got %d", i)
+	}
+}
+
+func TestVectorSearchStopsAtFirstMatch(t *testing.T) {
+	vector := Collect(sequence(1000)...)
+	visited := 0
+
+	if !vector.Contains(func(_ int, v int) bool {
+		visited++
+		return v == 40
+	}) {
+		t.Error("expected the vector to contain 40")
+	}
+
+	if visited != 41 {
+		t.Errorf("expected 41 values to be visited. got %d", visited)
+	}
+
+	if i, err := vector.SearchE(999); i != 999 || err != nil {
+		t.Errorf("expected 999 to be found at 999. got %d, %v", i, err)
+	}
+
+	if i, err := vector.SearchE(1000); i != -1 || err == nil {
+		t.Errorf("expected 1000 not to be found. got %d, %v", i, err)
+	}
+
+	if vector := (Vector[int]{}); vector.Contains(func(int, int) bool { return true }) {
+		t.Error("expected the empty vector not to contain any value")
+	}
+}
+
+func sequence(count int) []int {
+	values := make([]int, count)
+	for i := range values {
+		values[i] = i
+	}
+
+	return values
+}
@@ -0,0 +1,41 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	"github.com/thefuga/go-collections/errors"
+)
+
+// BinaryVersion is the current version of the binary encoding of the collections.
+const BinaryVersion byte = 1
+
+// EncodeBinary encodes v with gob, prefixed by BinaryVersion.
+func EncodeBinary(v any) ([]byte, error) {
+	buf := bytes.NewBuffer([]byte{BinaryVersion})
+
+	if err := gob.NewEncoder(buf).Encode(v); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// DecodeBinary decodes data encoded by EncodeBinary into v. Should data use another
+// version, an instance of errors.UnsupportedVersionError is returned. Should data be
+// malformed, an instance of errors.InvalidEncodingError is returned.
+func DecodeBinary(data []byte, v any) error {
+	if len(data) == 0 {
+		return errors.NewInvalidEncodingError()
+	}
+
+	if data[0] != BinaryVersion {
+		return errors.NewUnsupportedVersionError(data[0])
+	}
+
+	if err := gob.NewDecoder(bytes.NewReader(data[1:])).Decode(v); err != nil {
+		return errors.NewInvalidEncodingError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package internal
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Field describes an exported struct field.
+type Field struct {
+	// Name is the name given to the field by the tag, or its Go name when untagged.
+	Name  string
+	Index int
+	Type  reflect.Type
+}
+
+type fieldsKey struct {
+	t   reflect.Type
+	tag string
+}
+
+var fieldsCache sync.Map
+
+// Fields returns the exported fields of the struct type t, in declaration order. The
+// name of each field is read from the given struct tag key (e.g. `csv:"name"`), up to
+// the first comma. Fields tagged with "-" are skipped. Should tag be empty, or a field
+// be untagged, its Go name is used. Results are cached per type and tag.
+func Fields(t reflect.Type, tag string) []Field {
+	key := fieldsKey{t, tag}
+
+	if cached, ok := fieldsCache.Load(key); ok {
+		return cached.([]Field)
+	}
+
+	var fields []Field
+
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+
+		name := structField.Name
+
+		if tag != "" {
+			tagged, _, _ := strings.Cut(structField.Tag.Get(tag), ",")
+
+			if tagged == "-" {
+				continue
+			}
+
+			if tagged != "" {
+				name = tagged
+			}
+		}
+
+		fields = append(fields, Field{Name: name, Index: i, Type: structField.Type})
+	}
+
+	fieldsCache.Store(key, fields)
+
+	return fields
+}
+
+// FieldByName returns the value of the exported field of the struct v with the given
+// name, as returned by Fields with the given tag key. Should v not be a struct or the
+// field not exist, false is returned.
+func FieldByName(v reflect.Value, name, tag string) (reflect.Value, bool) {
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	for _, field := range Fields(v.Type(), tag) {
+		if field.Name == name {
+			return v.Field(field.Index), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
@@ -0,0 +1,132 @@
+package internal
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Formatter describes how Format prints a collection.
+type Formatter struct {
+	// Open and Close delimit the entries of the collection.
+	Open, Close string
+	// Keyed formats entries as key:value pairs. Otherwise, only values are printed.
+	Keyed bool
+	// Each calls f for every entry of the collection, in order, until f returns false.
+	Each func(f func(k, v any) bool)
+}
+
+// Format implements fmt.Formatter for collections described by formatter.
+//
+// %v prints the entries in a single line. %+v prints one entry per line, indenting
+// nested multi-line entries. %#v prints the entries in Go syntax, prefixed by the
+// type of c. Other verbs and flags are applied to each entry. The precision, when
+// given, limits the number of entries printed, replacing the remaining ones by an
+// ellipsis.
+func Format(s fmt.State, verb rune, c any, formatter Formatter) {
+	limit, truncate := s.Precision()
+	entry := entryFormat(s, verb)
+	separator := ":"
+
+	if s.Flag('+') {
+		separator = ": "
+	}
+
+	var entries []string
+
+	formatter.Each(func(k, v any) bool {
+		if truncate && len(entries) == limit {
+			entries = append(entries, "...")
+			return false
+		}
+
+		if formatter.Keyed {
+			entries = append(entries, fmt.Sprintf(entry, k)+separator+fmt.Sprintf(entry, v))
+		} else {
+			entries = append(entries, fmt.Sprintf(entry, v))
+		}
+
+		return true
+	})
+
+	switch {
+	case verb == 'v' && s.Flag('#'):
+		fmt.Fprintf(s, "%T{%s}", c, strings.Join(entries, ", "))
+	case s.Flag('+') && len(entries) > 0:
+		fmt.Fprint(s, formatter.Open, "\n")
+
+		for _, e := range entries {
+			fmt.Fprint(s, "  ", strings.ReplaceAll(e, "\n", "\n  "), "\n")
+		}
+
+		fmt.Fprint(s, formatter.Close)
+	default:
+		fmt.Fprint(s, formatter.Open, strings.Join(entries, " "), formatter.Close)
+	}
+}
+
+// entryFormat returns the format string used to print each entry, which holds the
+// flags and width of s but not its precision.
+func entryFormat(s fmt.State, verb rune) string {
+	format := []byte{'%'}
+
+	for _, flag := range "+-# 0" {
+		if s.Flag(int(flag)) {
+			format = append(format, byte(flag))
+		}
+	}
+
+	if width, ok := s.Width(); ok {
+		format = strconv.AppendInt(format, int64(width), 10)
+	}
+
+	return string(append(format, string(verb)...))
+}
+
+// FormatString returns a format string holding the flags, width, precision and verb of
+// s, which reproduces the default formatting of a value when passed to fmt.Fprintf.
+func FormatString(s fmt.State, verb rune) string {
+	format := entryFormat(s, verb)
+
+	if precision, ok := s.Precision(); ok {
+		format = format[:len(format)-len(string(verb))] + "." + strconv.Itoa(precision) + string(verb)
+	}
+
+	return format
+}
+
+// SortKeys sorts keys the same way fmt sorts map keys: numbers and strings by value,
+// booleans with false first and anything else by its printed form.
+func SortKeys[K comparable](keys []K) {
+	sort.SliceStable(keys, func(i, j int) bool {
+		return LessKey(keys[i], keys[j])
+	})
+}
+
+// LessKey checks if a sorts before b, the same way SortKeys does.
+func LessKey(a, b any) bool {
+	return lessKey(reflect.ValueOf(a), reflect.ValueOf(b))
+}
+
+func lessKey(a, b reflect.Value) bool {
+	if a.Kind() != b.Kind() {
+		return fmt.Sprint(a) < fmt.Sprint(b)
+	}
+
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() < b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() < b.Float()
+	case reflect.String:
+		return a.String() < b.String()
+	case reflect.Bool:
+		return !a.Bool() && b.Bool()
+	}
+
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
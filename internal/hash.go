@@ -0,0 +1,125 @@
+package internal
+
+import (
+	"fmt"
+	"hash/maphash"
+	"math"
+	"reflect"
+)
+
+var seed = maphash.MakeSeed()
+
+// Hash returns a 64 bits hash of k. Equal keys always hash to the same value during
+// the execution of the program, but the hashes must not be persisted.
+// Common key types are hashed directly. Any other type is hashed by walking its value
+// the same way == compares it: pointers, channels and unsafe pointers by address,
+// structs by their non-blank fields, arrays by their elements and interfaces by their
+// dynamic values. Floats are normalised so +0 and -0 share a hash.
+func Hash[K comparable](k K) uint64 {
+	switch v := any(k).(type) {
+	case string:
+		return maphash.String(seed, v)
+	case int:
+		return mix(uint64(v))
+	case int8:
+		return mix(uint64(v))
+	case int16:
+		return mix(uint64(v))
+	case int32:
+		return mix(uint64(v))
+	case int64:
+		return mix(uint64(v))
+	case uint:
+		return mix(uint64(v))
+	case uint8:
+		return mix(uint64(v))
+	case uint16:
+		return mix(uint64(v))
+	case uint32:
+		return mix(uint64(v))
+	case uint64:
+		return mix(v)
+	case uintptr:
+		return mix(uint64(v))
+	case float32:
+		return hashFloat(float64(v))
+	case float64:
+		return hashFloat(v)
+	case bool:
+		if v {
+			return mix(1)
+		}
+		return mix(0)
+	}
+
+	return hashValue(reflect.ValueOf(any(k)))
+}
+
+func hashValue(v reflect.Value) uint64 {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return mix(0)
+	case reflect.String:
+		return maphash.String(seed, v.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return mix(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return mix(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return hashFloat(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		return combine(hashFloat(real(c)), hashFloat(imag(c)))
+	case reflect.Bool:
+		if v.Bool() {
+			return mix(1)
+		}
+		return mix(0)
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		return mix(uint64(v.Pointer()))
+	case reflect.Interface:
+		return hashValue(v.Elem())
+	case reflect.Array:
+		h := mix(uint64(v.Len()))
+		for i := 0; i < v.Len(); i++ {
+			h = combine(h, hashValue(v.Index(i)))
+		}
+		return h
+	case reflect.Struct:
+		h := mix(uint64(v.NumField()))
+		for i := 0; i < v.NumField(); i++ {
+			// Blank fields are ignored by ==.
+			if v.Type().Field(i).Name != "_" {
+				h = combine(h, hashValue(v.Field(i)))
+			}
+		}
+		return h
+	}
+
+	// Only comparable types reach Hash, so this is never reached.
+	panic(fmt.Sprintf("internal: can't hash %v", v.Type()))
+}
+
+// combine mixes the hash of a value into the hash of its container.
+func combine(h, x uint64) uint64 {
+	return mix(h ^ (x + 0x9e3779b97f4a7c15 + h<<6 + h>>2))
+}
+
+func hashFloat(f float64) uint64 {
+	if f == 0 {
+		// +0 and -0 are equal, so they must share the same hash.
+		f = 0
+	}
+
+	return mix(math.Float64bits(f))
+}
+
+// mix is the splitmix64 finalizer, which spreads the bits of sequential values.
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,76 @@
+//go:build go1.21
+
+package internal
+
+import (
+	"encoding"
+	"fmt"
+	"log/slog"
+	"reflect"
+)
+
+// Redacted replaces redacted values on logs.
+const Redacted = "[REDACTED]"
+
+// LogValue builds a group value holding the first limit entries given by each, which
+// must call f for every entry, in order, until f returns false. Keys are printed with
+// fmt.Sprint. Values matching redact are replaced by Redacted. Struct values are logged
+// as groups of their exported fields, named by the `log` tag, unless they implement
+// fmt.Stringer or encoding.TextMarshaler. Redact is also matched against each field
+// name and value. Pointers are only followed on entries, not on fields. Should count
+// exceed limit, an entry named "..." holding the number of omitted entries is appended.
+// A limit lower than 1 logs every entry.
+func LogValue(count, limit int, redact func(k, v any) bool, each func(f func(k, v any) bool)) slog.Value {
+	if limit < 1 || limit > count {
+		limit = count
+	}
+
+	attrs := make([]slog.Attr, 0, limit+1)
+
+	each(func(k, v any) bool {
+		if len(attrs) == limit {
+			return false
+		}
+
+		attrs = append(attrs, logAttr(fmt.Sprint(k), k, v, redact, true))
+		return true
+	})
+
+	if count > limit {
+		attrs = append(attrs, slog.Int("...", count-limit))
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+func logAttr(key string, k, v any, redact func(k, v any) bool, follow bool) slog.Attr {
+	if redact != nil && redact(k, v) {
+		return slog.String(key, Redacted)
+	}
+
+	switch v := v.(type) {
+	case slog.LogValuer, encoding.TextMarshaler:
+		return slog.Any(key, v)
+	case fmt.Stringer:
+		return slog.String(key, v.String())
+	}
+
+	value := reflect.ValueOf(v)
+	if follow && value.Kind() == reflect.Pointer && !value.IsNil() {
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Struct {
+		return slog.Any(key, v)
+	}
+
+	fields := Fields(value.Type(), "log")
+	attrs := make([]slog.Attr, len(fields))
+
+	for i, field := range fields {
+		fieldValue := value.Field(field.Index).Interface()
+		attrs[i] = logAttr(field.Name, field.Name, fieldValue, redact, false)
+	}
+
+	return slog.Attr{Key: key, Value: slog.GroupValue(attrs...)}
+}
@@ -16,6 +16,24 @@ func Max[T Relational](a, b T) T {
 	return b
 }
 
+// Compare returns -1, 0 or 1 when a is lower than, equal to or greater than b. NaNs are
+// ordered before every other value and equal to each other, the same way cmp.Compare
+// does.
+func Compare[T Relational](a, b T) int {
+	aNaN, bNaN := a != a, b != b
+
+	switch {
+	case aNaN && bNaN:
+		return 0
+	case aNaN || a < b:
+		return -1
+	case bNaN || a > b:
+		return 1
+	}
+
+	return 0
+}
+
 func DivCeil[T Number](a, b T) T {
 	return T(math.Ceil(float64(a) / float64(b)))
 }
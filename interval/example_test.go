@@ -0,0 +1,36 @@
+package interval
+
+import "fmt"
+
+func ExampleSet() {
+	busy := NewSet(New(9, 12), New(11, 13), New(14, 17))
+
+	fmt.Println(busy.Intervals())
+	fmt.Println(busy.Contains(13))
+	// Output:
+	// [[9, 13) [14, 17)]
+	// false
+}
+
+func ExampleTree_Stab() {
+	tree := NewTree[int, string]().
+		Put(New(0, 10), "a").
+		Put(New(5, 15), "b").
+		Put(New(12, 20), "c")
+
+	for _, e := range tree.Stab(7) {
+		fmt.Println(e.Value)
+	}
+	// Output:
+	// a
+	// b
+}
+
+func ExampleSet_Format() {
+	fmt.Printf("%+v\n", NewSet(New(1, 3), New(5, 8)))
+	// Output:
+	// {
+	//   [1, 3)
+	//   [5, 8)
+	// }
+}
@@ -0,0 +1,28 @@
+package interval
+
+import (
+	"fmt"
+
+	"github.com/thefuga/go-collections/internal"
+)
+
+// String returns the set formatted with %v.
+func (s *Set[T]) String() string { return fmt.Sprint(s) }
+
+// Format implements fmt.Formatter, printing the intervals in ascending order. %v
+// prints the intervals in a single line, %+v prints one interval per line and %#v
+// prints the set in Go syntax. The precision limits the number of intervals printed
+// (e.g. %.10v).
+func (s *Set[T]) Format(state fmt.State, verb rune) {
+	internal.Format(state, verb, s, internal.Formatter{
+		Open:  "{",
+		Close: "}",
+		Each: func(f func(k, v any) bool) {
+			for i, interval := range s.Intervals() {
+				if !f(i, interval) {
+					return
+				}
+			}
+		},
+	})
+}
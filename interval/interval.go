@@ -0,0 +1,49 @@
+// Package interval provides half-open intervals, sets of disjoint intervals, an
+// interval tree for stabbing queries and a lazy integer range.
+package interval
+
+import (
+	"fmt"
+
+	"github.com/thefuga/go-collections/internal"
+)
+
+// Interval is the half-open interval [Start, End). Intervals where Start >= End are
+// empty.
+type Interval[T internal.Relational] struct {
+	Start T
+	End   T
+}
+
+// New makes the interval [start, end).
+func New[T internal.Relational](start, end T) Interval[T] {
+	return Interval[T]{Start: start, End: end}
+}
+
+// IsEmpty checks if the interval holds no values.
+func (i Interval[T]) IsEmpty() bool { return i.Start >= i.End }
+
+// Contains checks if v is within the interval.
+func (i Interval[T]) Contains(v T) bool { return i.Start <= v && v < i.End }
+
+// Overlaps checks if both intervals share at least one value.
+func (i Interval[T]) Overlaps(other Interval[T]) bool {
+	return !i.Intersection(other).IsEmpty()
+}
+
+// Touches checks if both intervals overlap or are adjacent, i.e. their union is a
+// single interval.
+func (i Interval[T]) Touches(other Interval[T]) bool {
+	return !i.IsEmpty() && !other.IsEmpty() && i.Start <= other.End && other.Start <= i.End
+}
+
+// Intersection returns the interval shared by both intervals, which is empty when
+// they don't overlap.
+func (i Interval[T]) Intersection(other Interval[T]) Interval[T] {
+	return Interval[T]{Start: internal.Max(i.Start, other.Start), End: internal.Min(i.End, other.End)}
+}
+
+// String formats the interval as [Start, End).
+func (i Interval[T]) String() string {
+	return fmt.Sprintf("[%v, %v)", i.Start, i.End)
+}
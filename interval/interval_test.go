@@ -0,0 +1,224 @@
+package interval
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/thefuga/go-collections"
+	"github.com/thefuga/go-collections/slice"
+)
+
+func TestInterval(t *testing.T) {
+	i := New(10, 20)
+
+	testCases := []struct {
+		description  string
+		other        Interval[int]
+		overlaps     bool
+		touches      bool
+		intersection Interval[int]
+	}{
+		{"inside", New(12, 15), true, true, New(12, 15)},
+		{"partial", New(15, 25), true, true, New(15, 20)},
+		{"adjacent", New(20, 25), false, true, New(20, 20)},
+		{"disjoint", New(0, 5), false, false, New(10, 5)},
+		{"empty", New(15, 15), false, false, New(15, 15)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if i.Overlaps(tc.other) != tc.overlaps || tc.other.Overlaps(i) != tc.overlaps {
+				t.Errorf("expected overlaps to be %v", tc.overlaps)
+			}
+
+			if i.Touches(tc.other) != tc.touches {
+				t.Errorf("expected touches to be %v", tc.touches)
+			}
+
+			if intersection := i.Intersection(tc.other); intersection != tc.intersection {
+				t.Errorf("expected %v. got %v", tc.intersection, intersection)
+			}
+		})
+	}
+
+	if !i.Contains(10) || i.Contains(20) || i.String() != "[10, 20)" {
+		t.Error("intervals must be half-open")
+	}
+}
+
+func TestSet(t *testing.T) {
+	s := NewSet(New(1, 3), New(10, 12), New(5, 7), New(3, 4), New(6, 8), New(20, 20))
+
+	if expected := slice.Collect(New(1, 4), New(5, 8), New(10, 12)); !reflect.DeepEqual(s.Intervals(), expected) {
+		t.Errorf("expected %v. got %v", expected, s.Intervals())
+	}
+
+	s.Add(New(0, 11))
+
+	if expected := slice.Collect(New(0, 12)); !reflect.DeepEqual(s.Intervals(), expected) {
+		t.Errorf("expected %v. got %v", expected, s.Intervals())
+	}
+
+	s.Remove(New(2, 4)).Remove(New(11, 30)).Remove(New(-5, 1))
+
+	if expected := slice.Collect(New(1, 2), New(4, 11)); !reflect.DeepEqual(s.Intervals(), expected) {
+		t.Errorf("expected %v. got %v", expected, s.Intervals())
+	}
+
+	if !s.Contains(1) || s.Contains(2) || s.Contains(11) || !s.Contains(10) {
+		t.Error("unexpected Contains results")
+	}
+
+	if !s.Overlaps(New(0, 2)) || s.Overlaps(New(2, 4)) || !s.Overlaps(New(10, 20)) {
+		t.Error("unexpected Overlaps results")
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	left := NewSet(New(0, 10), New(20, 30))
+	right := NewSet(New(5, 25), New(28, 40))
+
+	testCases := []struct {
+		description string
+		result      *Set[int]
+		expected    slice.Collection[Interval[int]]
+	}{
+		{"union", left.Union(right), slice.Collect(New(0, 40))},
+		{"intersection", left.Intersection(right), slice.Collect(New(5, 10), New(20, 25), New(28, 30))},
+		{"subtract", left.Subtract(right), slice.Collect(New(0, 5), New(25, 28))},
+		{"subtract from", right.Subtract(left), slice.Collect(New(10, 20), New(30, 40))},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if !reflect.DeepEqual(tc.result.Intervals(), tc.expected) {
+				t.Errorf("expected %v. got %v", tc.expected, tc.result.Intervals())
+			}
+		})
+	}
+
+	if left.Count() != 2 || right.Count() != 2 {
+		t.Error("set operations must not modify the operands")
+	}
+}
+
+func TestTree(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	tree := NewTree[int, int]()
+	var entries []Entry[int, int]
+
+	for i := 0; i < 500; i++ {
+		start := random.Intn(1000)
+		e := Entry[int, int]{Interval: New(start, start+random.Intn(50)), Value: i}
+
+		tree.Put(e.Interval, e.Value)
+		if !e.Interval.IsEmpty() {
+			entries = append(entries, e)
+		}
+
+		// Queries in between puts force rebuilds.
+		if i%100 == 0 {
+			tree.Stab(start)
+		}
+	}
+
+	sortValues := func(c slice.Collection[Entry[int, int]]) []int {
+		values := make([]int, 0, len(c))
+		for _, e := range c {
+			values = append(values, e.Value)
+		}
+		sort.Ints(values)
+		return values
+	}
+
+	for p := -10; p < 1060; p += 7 {
+		var expected []Entry[int, int]
+		for _, e := range entries {
+			if e.Interval.Contains(p) {
+				expected = append(expected, e)
+			}
+		}
+
+		if got := sortValues(tree.Stab(p)); !reflect.DeepEqual(got, sortValues(expected)) {
+			t.Fatalf("unexpected stabbing result for %d: %v", p, got)
+		}
+
+		query := New(p, p+20)
+		expected = expected[:0]
+		for _, e := range entries {
+			if e.Interval.Overlaps(query) {
+				expected = append(expected, e)
+			}
+		}
+
+		if got := sortValues(tree.Overlapping(query)); !reflect.DeepEqual(got, sortValues(expected)) {
+			t.Fatalf("unexpected overlapping result for %v: %v", query, got)
+		}
+	}
+
+	if tree.Count() != len(entries) || tree.Overlapping(New(5, 5)) != nil {
+		t.Error("empty intervals must be ignored")
+	}
+}
+
+func TestRange(t *testing.T) {
+	r := NewRange(3, 7)
+
+	if !reflect.DeepEqual([]int(r.ToSliceCollection()), collections.Range(3, 7)) {
+		t.Errorf("expected %v. got %v", collections.Range(3, 7), r.ToSliceCollection())
+	}
+
+	if r.Count() != 5 || !r.Contains(7) || r.Contains(8) || r.Get(2) != 5 {
+		t.Error("unexpected range queries")
+	}
+
+	if _, err := r.GetE(5); err == nil || err.Error() != "value not found: index out of bounds" {
+		t.Errorf("expected an index out of bounds error. got %v", err)
+	}
+
+	if empty := NewRange(1, 0); empty.Count() != 0 || len(empty.ToSliceCollection()) != 0 {
+		t.Error("expected the range to be empty")
+	}
+
+	if r.ToInterval() != New(3, 8) {
+		t.Errorf("unexpected interval %v", r.ToInterval())
+	}
+
+	var last uint8
+	NewRange[uint8](250, math.MaxUint8).Each(func(_ int, v uint8) { last = v })
+
+	if last != math.MaxUint8 {
+		t.Errorf("expected ranges ending at the maximum value not to overflow. got %d", last)
+	}
+}
+
+func TestRangeFullWidth(t *testing.T) {
+	signed := NewRange[int8](math.MinInt8, math.MaxInt8)
+
+	if signed.Count() != 256 || signed.IsEmpty() {
+		t.Errorf("expected 256 values. got %d", signed.Count())
+	}
+
+	if v, err := signed.GetE(255); err != nil || v != math.MaxInt8 {
+		t.Errorf("expected %d. got %d, %v", math.MaxInt8, v, err)
+	}
+
+	if v := signed.Get(200); v != 72 {
+		t.Errorf("expected 72. got %d", v)
+	}
+
+	if values := signed.ToSliceCollection(); len(values) != 256 || values[0] != math.MinInt8 {
+		t.Errorf("expected 256 values starting at %d. got %d values", math.MinInt8, len(values))
+	}
+
+	if unsigned := NewRange[uint8](0, math.MaxUint8); unsigned.Count() != 256 {
+		t.Errorf("expected 256 values. got %d", unsigned.Count())
+	}
+
+	if wide := NewRange[int64](math.MinInt64, math.MaxInt64); wide.Count() != math.MaxInt {
+		t.Errorf("expected the count to be capped at %d. got %d", math.MaxInt, wide.Count())
+	}
+}
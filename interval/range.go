@@ -0,0 +1,99 @@
+package interval
+
+import (
+	"math"
+
+	"github.com/thefuga/go-collections/errors"
+	"github.com/thefuga/go-collections/internal"
+	"github.com/thefuga/go-collections/slice"
+)
+
+// Range is the lazy counterpart of collections.Range: it represents the integers
+// from Min to Max, both inclusive, without allocating them. Ranges where Min > Max
+// are empty.
+type Range[T internal.Integer] struct {
+	Min T
+	Max T
+}
+
+// NewRange makes the range [min, max].
+func NewRange[T internal.Integer](min, max T) Range[T] {
+	return Range[T]{Min: min, Max: max}
+}
+
+// Count returns the number of integers in the range. Ranges holding more integers
+// than an int can represent are counted as math.MaxInt.
+func (r Range[T]) Count() int {
+	if r.Min > r.Max {
+		return 0
+	}
+
+	// The bounds are widened before subtracting, so wide ranges of small types don't
+	// overflow. Two's complement keeps the difference right for negative bounds.
+	diff := uint64(r.Max) - uint64(r.Min)
+	if diff >= math.MaxInt {
+		return math.MaxInt
+	}
+
+	return int(diff) + 1
+}
+
+// IsEmpty checks if the range holds no integers.
+func (r Range[T]) IsEmpty() bool { return r.Min > r.Max }
+
+// Contains checks if v is within the range.
+func (r Range[T]) Contains(v T) bool { return r.Min <= v && v <= r.Max }
+
+// Get calls GetE, omitting the error.
+func (r Range[T]) Get(i int) T {
+	v, _ := r.GetE(i)
+	return v
+}
+
+// GetE returns the i-th integer of the range. Errors are returned the same way as on
+// collections.GetE.
+func (r Range[T]) GetE(i int) (T, error) {
+	if r.IsEmpty() {
+		return 0, errors.NewEmptyCollectionError(errors.NewValueNotFoundError())
+	}
+
+	if i < 0 || i >= r.Count() {
+		return 0, errors.NewIndexOutOfBoundsError(errors.NewValueNotFoundError())
+	}
+
+	return r.Min + T(i), nil
+}
+
+// Each calls f with every integer of the range, in ascending order.
+func (r Range[T]) Each(f func(i int, v T)) Range[T] {
+	if r.IsEmpty() {
+		return r
+	}
+
+	for i, v := 0, r.Min; ; i, v = i+1, v+1 {
+		f(i, v)
+
+		// Checked before incrementing, so ranges ending at the maximum value of T
+		// don't overflow.
+		if v == r.Max {
+			return r
+		}
+	}
+}
+
+// ToInterval returns the range as the half-open interval [Min, Max+1). Ranges ending
+// at the maximum value of T can't be represented, as Max+1 overflows.
+func (r Range[T]) ToInterval() Interval[T] {
+	return Interval[T]{Start: r.Min, End: r.Max + 1}
+}
+
+// ToSliceCollection materializes the range, just like collections.Range.
+func (r Range[T]) ToSliceCollection() slice.Collection[T] {
+	values := make(slice.Collection[T], 0, r.Count())
+
+	r.Each(func(_ int, v T) {
+		values = append(values, v)
+	})
+
+	return values
+}
@@ -0,0 +1,150 @@
+package interval
+
+import (
+	"sort"
+
+	"github.com/thefuga/go-collections/internal"
+	"github.com/thefuga/go-collections/slice"
+)
+
+// Set is a set of values represented as sorted, disjoint and non-adjacent intervals.
+// Overlapping or adjacent intervals are merged when added. The zero value is an empty
+// set ready to use.
+type Set[T internal.Relational] struct {
+	intervals []Interval[T]
+}
+
+// NewSet makes a set holding the values of the given intervals.
+func NewSet[T internal.Relational](intervals ...Interval[T]) *Set[T] {
+	s := &Set[T]{}
+
+	for _, i := range intervals {
+		s.Add(i)
+	}
+
+	return s
+}
+
+// Add adds the values of i to the set, merging it with the intervals it touches.
+// Empty intervals are ignored.
+func (s *Set[T]) Add(i Interval[T]) *Set[T] {
+	if i.IsEmpty() {
+		return s
+	}
+
+	// first is the first interval ending at or after i starts, so it may touch i.
+	first := sort.Search(len(s.intervals), func(j int) bool { return s.intervals[j].End >= i.Start })
+	last := first
+
+	for ; last < len(s.intervals) && s.intervals[last].Start <= i.End; last++ {
+		i.Start = internal.Min(i.Start, s.intervals[last].Start)
+		i.End = internal.Max(i.End, s.intervals[last].End)
+	}
+
+	merged := make([]Interval[T], 0, len(s.intervals)-(last-first)+1)
+	merged = append(merged, s.intervals[:first]...)
+	merged = append(merged, i)
+	s.intervals = append(merged, s.intervals[last:]...)
+
+	return s
+}
+
+// Remove removes the values of i from the set, splitting intervals as needed.
+func (s *Set[T]) Remove(i Interval[T]) *Set[T] {
+	if i.IsEmpty() {
+		return s
+	}
+
+	remaining := make([]Interval[T], 0, len(s.intervals)+1)
+
+	for _, current := range s.intervals {
+		if !current.Overlaps(i) {
+			remaining = append(remaining, current)
+			continue
+		}
+
+		if before := New(current.Start, i.Start); !before.IsEmpty() {
+			remaining = append(remaining, before)
+		}
+
+		if after := New(i.End, current.End); !after.IsEmpty() {
+			remaining = append(remaining, after)
+		}
+	}
+
+	s.intervals = remaining
+
+	return s
+}
+
+// Contains checks if v is on the set.
+func (s *Set[T]) Contains(v T) bool {
+	j := sort.Search(len(s.intervals), func(j int) bool { return s.intervals[j].End > v })
+	return j < len(s.intervals) && s.intervals[j].Contains(v)
+}
+
+// Overlaps checks if any value of i is on the set.
+func (s *Set[T]) Overlaps(i Interval[T]) bool {
+	j := sort.Search(len(s.intervals), func(j int) bool { return s.intervals[j].End > i.Start })
+	return j < len(s.intervals) && s.intervals[j].Overlaps(i)
+}
+
+// Union returns a new set holding the values of both sets.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	union := s.Copy()
+
+	for _, i := range other.intervals {
+		union.Add(i)
+	}
+
+	return union
+}
+
+// Intersection returns a new set holding the values present on both sets.
+func (s *Set[T]) Intersection(other *Set[T]) *Set[T] {
+	intersection := &Set[T]{}
+
+	for i, j := 0, 0; i < len(s.intervals) && j < len(other.intervals); {
+		if shared := s.intervals[i].Intersection(other.intervals[j]); !shared.IsEmpty() {
+			intersection.intervals = append(intersection.intervals, shared)
+		}
+
+		if s.intervals[i].End < other.intervals[j].End {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	return intersection
+}
+
+// Subtract returns a new set holding the values of s not present on other.
+func (s *Set[T]) Subtract(other *Set[T]) *Set[T] {
+	difference := s.Copy()
+
+	for _, i := range other.intervals {
+		difference.Remove(i)
+	}
+
+	return difference
+}
+
+// Copy returns a copy of the set.
+func (s *Set[T]) Copy() *Set[T] {
+	copied := &Set[T]{intervals: make([]Interval[T], len(s.intervals))}
+	copy(copied.intervals, s.intervals)
+
+	return copied
+}
+
+// Count returns the number of disjoint intervals on the set.
+func (s *Set[T]) Count() int { return len(s.intervals) }
+
+// IsEmpty checks if the set is empty.
+func (s *Set[T]) IsEmpty() bool { return len(s.intervals) == 0 }
+
+// Intervals returns the disjoint intervals of the set, sorted in ascending order.
+func (s *Set[T]) Intervals() slice.Collection[Interval[T]] {
+	return slice.Collection[Interval[T]](s.intervals).Copy()
+}
@@ -0,0 +1,141 @@
+package interval
+
+import (
+	"sort"
+
+	"github.com/thefuga/go-collections/internal"
+	"github.com/thefuga/go-collections/slice"
+)
+
+// Entry is an interval and its associated value, stored on a Tree.
+type Entry[T internal.Relational, V any] struct {
+	Interval Interval[T]
+	Value    V
+}
+
+// Tree is an interval tree, answering which intervals contain a value (stabbing
+// queries) or overlap an interval in O(log n + m), where m is the number of results.
+// Intervals may overlap and repeat. The tree is an implicit balanced tree over the
+// entries sorted by start, augmented with the maximum end of each subtree. It is
+// rebuilt lazily on the first query following a Put, in O(n log n), so it best
+// suits batches of puts followed by many queries. The zero value is an empty tree
+// ready to use.
+type Tree[T internal.Relational, V any] struct {
+	entries []Entry[T, V]
+	maxEnd  []T
+	dirty   bool
+}
+
+// NewTree makes a tree holding the given entries.
+func NewTree[T internal.Relational, V any](entries ...Entry[T, V]) *Tree[T, V] {
+	t := &Tree[T, V]{}
+
+	for _, e := range entries {
+		t.Put(e.Interval, e.Value)
+	}
+
+	return t
+}
+
+// Put adds the interval i associated to value. Empty intervals are ignored, as they
+// contain no values.
+func (t *Tree[T, V]) Put(i Interval[T], value V) *Tree[T, V] {
+	if i.IsEmpty() {
+		return t
+	}
+
+	t.entries = append(t.entries, Entry[T, V]{Interval: i, Value: value})
+	t.dirty = true
+
+	return t
+}
+
+// Count returns the number of intervals on the tree.
+func (t *Tree[T, V]) Count() int { return len(t.entries) }
+
+// Stab returns the entries which intervals contain p, sorted by start.
+func (t *Tree[T, V]) Stab(p T) slice.Collection[Entry[T, V]] {
+	return t.collect(p, func(start T) bool { return start > p })
+}
+
+// Overlapping returns the entries which intervals overlap i, sorted by start.
+func (t *Tree[T, V]) Overlapping(i Interval[T]) slice.Collection[Entry[T, V]] {
+	if i.IsEmpty() {
+		return nil
+	}
+
+	return t.collect(i.Start, func(start T) bool { return start >= i.End })
+}
+
+// collect returns the entries ending after from which don't start beyond the query.
+func (t *Tree[T, V]) collect(from T, beyond func(start T) bool) slice.Collection[Entry[T, V]] {
+	t.build()
+
+	var found slice.Collection[Entry[T, V]]
+
+	t.query(0, len(t.entries), from, beyond, func(e Entry[T, V]) {
+		found = append(found, e)
+	})
+
+	return found
+}
+
+// build sorts the entries and computes the maximum end of every subtree.
+func (t *Tree[T, V]) build() {
+	if !t.dirty {
+		return
+	}
+
+	sort.SliceStable(t.entries, func(i, j int) bool {
+		return t.entries[i].Interval.Start < t.entries[j].Interval.Start
+	})
+
+	t.maxEnd = make([]T, len(t.entries))
+	t.augment(0, len(t.entries))
+	t.dirty = false
+}
+
+// augment computes the maximum end of the subtree holding the entries in [lo, hi),
+// rooted at their middle entry.
+func (t *Tree[T, V]) augment(lo, hi int) T {
+	mid := (lo + hi) / 2
+	maxEnd := t.entries[mid].Interval.End
+
+	if lo < mid {
+		maxEnd = internal.Max(maxEnd, t.augment(lo, mid))
+	}
+
+	if mid+1 < hi {
+		maxEnd = internal.Max(maxEnd, t.augment(mid+1, hi))
+	}
+
+	t.maxEnd[mid] = maxEnd
+
+	return maxEnd
+}
+
+func (t *Tree[T, V]) query(lo, hi int, from T, beyond func(start T) bool, f func(e Entry[T, V])) {
+	if lo >= hi {
+		return
+	}
+
+	mid := (lo + hi) / 2
+
+	// No interval of the subtree ends after from.
+	if t.maxEnd[mid] <= from {
+		return
+	}
+
+	t.query(lo, mid, from, beyond, f)
+
+	// Neither this entry nor the ones at its right start within the query.
+	if beyond(t.entries[mid].Interval.Start) {
+		return
+	}
+
+	if t.entries[mid].Interval.End > from {
+		f(t.entries[mid])
+	}
+
+	t.query(mid+1, hi, from, beyond, f)
+}
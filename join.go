@@ -0,0 +1,162 @@
+package collections
+
+import "github.com/thefuga/go-collections/internal"
+
+// Joined holds a pair of values matched by a join. On outer joins, one of the sides
+// might be absent, which is reported by HasLeft and HasRight. Absent sides hold
+// their zeroed value.
+type Joined[L, R any] struct {
+	Left     L
+	Right    R
+	HasLeft  bool
+	HasRight bool
+}
+
+// InnerJoin matches every element of left to every element of right with the same key.
+// The join is hash based, executing in O(n+m) plus the number of matched pairs.
+// The result follows the order of left and, for each left element, the order of right.
+func InnerJoin[L, R any, K comparable](
+	left []L, right []R, leftKey func(l L) K, rightKey func(r R) K,
+) []Joined[L, R] {
+	rightGroups := GroupBy(right, rightKey)
+	var joined []Joined[L, R]
+
+	for _, l := range left {
+		for _, r := range rightGroups[leftKey(l)] {
+			joined = append(joined, Joined[L, R]{Left: l, Right: r, HasLeft: true, HasRight: true})
+		}
+	}
+
+	return joined
+}
+
+// LeftJoin works like InnerJoin, but also includes the left elements without a match
+// on the right, which will have HasRight set to false.
+func LeftJoin[L, R any, K comparable](
+	left []L, right []R, leftKey func(l L) K, rightKey func(r R) K,
+) []Joined[L, R] {
+	rightGroups := GroupBy(right, rightKey)
+	joined := make([]Joined[L, R], 0, len(left))
+
+	for _, l := range left {
+		matches, ok := rightGroups[leftKey(l)]
+		if !ok {
+			joined = append(joined, Joined[L, R]{Left: l, HasLeft: true})
+			continue
+		}
+
+		for _, r := range matches {
+			joined = append(joined, Joined[L, R]{Left: l, Right: r, HasLeft: true, HasRight: true})
+		}
+	}
+
+	return joined
+}
+
+// RightJoin works like InnerJoin, but also includes the right elements without a match
+// on the left, which will have HasLeft set to false. The result follows the order of right.
+func RightJoin[L, R any, K comparable](
+	left []L, right []R, leftKey func(l L) K, rightKey func(r R) K,
+) []Joined[L, R] {
+	leftGroups := GroupBy(left, leftKey)
+	joined := make([]Joined[L, R], 0, len(right))
+
+	for _, r := range right {
+		matches, ok := leftGroups[rightKey(r)]
+		if !ok {
+			joined = append(joined, Joined[L, R]{Right: r, HasRight: true})
+			continue
+		}
+
+		for _, l := range matches {
+			joined = append(joined, Joined[L, R]{Left: l, Right: r, HasLeft: true, HasRight: true})
+		}
+	}
+
+	return joined
+}
+
+// FullOuterJoin is the combination of LeftJoin and RightJoin. The result holds the
+// LeftJoin result followed by the right elements without a match on the left.
+func FullOuterJoin[L, R any, K comparable](
+	left []L, right []R, leftKey func(l L) K, rightKey func(r R) K,
+) []Joined[L, R] {
+	joined := LeftJoin(left, right, leftKey, rightKey)
+	leftKeys := KeyBy(left, leftKey)
+
+	for _, r := range right {
+		if _, ok := leftKeys[rightKey(r)]; !ok {
+			joined = append(joined, Joined[L, R]{Right: r, HasRight: true})
+		}
+	}
+
+	return joined
+}
+
+// SemiJoin returns the left elements with at least one match on the right. Each left
+// element is returned at most once, regardless of the number of matches.
+func SemiJoin[L, R any, K comparable](
+	left []L, right []R, leftKey func(l L) K, rightKey func(r R) K,
+) []L {
+	rightKeys := KeyBy(right, rightKey)
+	var joined []L
+
+	for _, l := range left {
+		if _, ok := rightKeys[leftKey(l)]; ok {
+			joined = append(joined, l)
+		}
+	}
+
+	return joined
+}
+
+// AntiJoin returns the left elements without a match on the right.
+func AntiJoin[L, R any, K comparable](
+	left []L, right []R, leftKey func(l L) K, rightKey func(r R) K,
+) []L {
+	rightKeys := KeyBy(right, rightKey)
+	var joined []L
+
+	for _, l := range left {
+		if _, ok := rightKeys[leftKey(l)]; !ok {
+			joined = append(joined, l)
+		}
+	}
+
+	return joined
+}
+
+// MergeJoin is equivalent to InnerJoin, but expects both slices to be sorted ascending
+// by their keys. It executes in O(n+m) plus the number of matched pairs without allocating
+// any intermediary map. Should the slices not be sorted, the result is undefined.
+func MergeJoin[L, R any, K internal.Relational](
+	left []L, right []R, leftKey func(l L) K, rightKey func(r R) K,
+) []Joined[L, R] {
+	var joined []Joined[L, R]
+
+	for i, j := 0, 0; i < len(left) && j < len(right); {
+		lk, rk := leftKey(left[i]), rightKey(right[j])
+
+		switch {
+		case lk < rk:
+			i++
+		case lk > rk:
+			j++
+		default:
+			runEnd := j
+			for runEnd < len(right) && rightKey(right[runEnd]) == lk {
+				runEnd++
+			}
+
+			for ; i < len(left) && leftKey(left[i]) == lk; i++ {
+				for _, r := range right[j:runEnd] {
+					joined = append(joined, Joined[L, R]{Left: left[i], Right: r, HasLeft: true, HasRight: true})
+				}
+			}
+
+			j = runEnd
+		}
+	}
+
+	return joined
+}
@@ -0,0 +1,145 @@
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+type order struct {
+	ID     int
+	UserID int
+}
+
+func userAge(u user) int    { return u.Age }
+func orderUser(o order) int { return o.UserID }
+
+var (
+	joinUsers = []user{
+		{Name: "ana", Age: 1},
+		{Name: "bob", Age: 2},
+		{Name: "cid", Age: 3},
+	}
+
+	joinOrders = []order{
+		{ID: 10, UserID: 1},
+		{ID: 11, UserID: 3},
+		{ID: 12, UserID: 1},
+		{ID: 13, UserID: 4},
+	}
+)
+
+func matched(u user, o order) Joined[user, order] {
+	return Joined[user, order]{Left: u, Right: o, HasLeft: true, HasRight: true}
+}
+
+func leftOnly(u user) Joined[user, order] {
+	return Joined[user, order]{Left: u, HasLeft: true}
+}
+
+func rightOnly(o order) Joined[user, order] {
+	return Joined[user, order]{Right: o, HasRight: true}
+}
+
+func TestJoins(t *testing.T) {
+	ana, bob, cid := joinUsers[0], joinUsers[1], joinUsers[2]
+	o10, o11, o12, o13 := joinOrders[0], joinOrders[1], joinOrders[2], joinOrders[3]
+
+	testCases := []struct {
+		description string
+		join        func([]user, []order, func(user) int, func(order) int) []Joined[user, order]
+		expected    []Joined[user, order]
+	}{
+		{
+			"inner join",
+			InnerJoin[user, order, int],
+			[]Joined[user, order]{matched(ana, o10), matched(ana, o12), matched(cid, o11)},
+		},
+		{
+			"left join",
+			LeftJoin[user, order, int],
+			[]Joined[user, order]{matched(ana, o10), matched(ana, o12), leftOnly(bob), matched(cid, o11)},
+		},
+		{
+			"right join",
+			RightJoin[user, order, int],
+			[]Joined[user, order]{matched(ana, o10), matched(cid, o11), matched(ana, o12), rightOnly(o13)},
+		},
+		{
+			"full outer join",
+			FullOuterJoin[user, order, int],
+			[]Joined[user, order]{
+				matched(ana, o10), matched(ana, o12), leftOnly(bob), matched(cid, o11), rightOnly(o13),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			joined := tc.join(joinUsers, joinOrders, userAge, orderUser)
+
+			if !reflect.DeepEqual(joined, tc.expected) {
+				t.Errorf("expected %v. got %v", tc.expected, joined)
+			}
+		})
+	}
+}
+
+func TestJoinsWithEmptySides(t *testing.T) {
+	if joined := InnerJoin(joinUsers, []order{}, userAge, orderUser); len(joined) != 0 {
+		t.Errorf("expected inner join to be empty. got %v", joined)
+	}
+
+	if joined := LeftJoin(joinUsers, []order{}, userAge, orderUser); len(joined) != len(joinUsers) {
+		t.Errorf("expected left join to hold every left element. got %v", joined)
+	}
+
+	if joined := RightJoin([]user{}, joinOrders, userAge, orderUser); len(joined) != len(joinOrders) {
+		t.Errorf("expected right join to hold every right element. got %v", joined)
+	}
+}
+
+func TestSemiJoin(t *testing.T) {
+	expected := []user{joinUsers[0], joinUsers[2]}
+
+	if joined := SemiJoin(joinUsers, joinOrders, userAge, orderUser); !reflect.DeepEqual(joined, expected) {
+		t.Errorf("expected %v. got %v", expected, joined)
+	}
+}
+
+func TestAntiJoin(t *testing.T) {
+	expected := []user{joinUsers[1]}
+
+	if joined := AntiJoin(joinUsers, joinOrders, userAge, orderUser); !reflect.DeepEqual(joined, expected) {
+		t.Errorf("expected %v. got %v", expected, joined)
+	}
+}
+
+func TestMergeJoin(t *testing.T) {
+	left := []int{1, 2, 2, 4, 6}
+	right := []string{"2", "2", "3", "6", "7"}
+	toString := func(i int) string { return []string{"0", "1", "2", "3", "4", "5", "6"}[i] }
+	self := func(s string) string { return s }
+
+	expected := []Joined[int, string]{
+		{Left: 2, Right: "2", HasLeft: true, HasRight: true},
+		{Left: 2, Right: "2", HasLeft: true, HasRight: true},
+		{Left: 2, Right: "2", HasLeft: true, HasRight: true},
+		{Left: 2, Right: "2", HasLeft: true, HasRight: true},
+		{Left: 6, Right: "6", HasLeft: true, HasRight: true},
+	}
+
+	if joined := MergeJoin(left, right, toString, self); !reflect.DeepEqual(joined, expected) {
+		t.Errorf("expected %v. got %v", expected, joined)
+	}
+}
+
+func TestMergeJoinMatchesInnerJoin(t *testing.T) {
+	sortedOrders := []order{{10, 1}, {12, 1}, {11, 3}, {13, 4}}
+
+	merged := MergeJoin(joinUsers, sortedOrders, userAge, orderUser)
+	hashed := InnerJoin(joinUsers, sortedOrders, userAge, orderUser)
+
+	if !reflect.DeepEqual(merged, hashed) {
+		t.Errorf("expected merge join %v to equal inner join %v", merged, hashed)
+	}
+}
@@ -0,0 +1,169 @@
+package kv
+
+import (
+	"github.com/thefuga/go-collections/errors"
+	"github.com/thefuga/go-collections/internal"
+	"github.com/thefuga/go-collections/slice"
+)
+
+// BiMap is a one-to-one map, allowing lookups both by key and by value. Both keys
+// and values are unique. Make it with NewBiMap or CollectBiMap: like a nil map, the zero
+// value is an empty BiMap which may be read and forgotten from, but putting into it
+// panics.
+type BiMap[K comparable, V comparable] struct {
+	values Collection[K, V]
+	keys   Collection[V, K]
+}
+
+// NewBiMap makes an empty BiMap.
+func NewBiMap[K comparable, V comparable]() BiMap[K, V] {
+	return BiMap[K, V]{values: make(Collection[K, V]), keys: make(Collection[V, K])}
+}
+
+// CollectBiMap calls CollectBiMapE, omitting the error.
+func CollectBiMap[K comparable, V comparable](items map[K]V) BiMap[K, V] {
+	b, _ := CollectBiMapE(items)
+	return b
+}
+
+// CollectBiMapE makes a BiMap holding the given key-value pairs. Should two keys hold
+// the same value, only the key sorting first (see internal.SortKeys) is kept and an
+// errors.ValueConflictError is returned for the first conflicting value.
+func CollectBiMapE[K comparable, V comparable](items map[K]V) (BiMap[K, V], error) {
+	b := NewBiMap[K, V]()
+	var err error
+
+	keys := make([]K, 0, len(items))
+	for k := range items {
+		keys = append(keys, k)
+	}
+
+	internal.SortKeys(keys)
+
+	for _, k := range keys {
+		if putErr := b.PutE(k, items[k]); putErr != nil && err == nil {
+			err = putErr
+		}
+	}
+
+	return b, err
+}
+
+// Put calls PutE, omitting the error.
+func (b BiMap[K, V]) Put(k K, v V) BiMap[K, V] {
+	_ = b.PutE(k, v)
+	return b
+}
+
+// PutE maps k to v. Should k already be mapped to another value, an
+// errors.KeyConflictError is returned. Should v already be mapped to another key, an
+// errors.ValueConflictError is returned. The BiMap is left unchanged on conflicts.
+// See ForcePut.
+func (b BiMap[K, V]) PutE(k K, v V) error {
+	b.mustBeMade()
+
+	if current, ok := b.values[k]; ok && current != v {
+		return errors.NewKeyConflictError(k)
+	}
+
+	if current, ok := b.keys[v]; ok && current != k {
+		return errors.NewValueConflictError(v)
+	}
+
+	b.values.Put(k, v)
+	b.keys.Put(v, k)
+
+	return nil
+}
+
+// ForcePut maps k to v, removing any mapping of k or v to other values and keys.
+func (b BiMap[K, V]) ForcePut(k K, v V) BiMap[K, V] {
+	b.mustBeMade()
+
+	b.Forget(k)
+	b.ForgetValue(v)
+
+	b.values.Put(k, v)
+	b.keys.Put(v, k)
+
+	return b
+}
+
+// Get calls GetE, omitting the error.
+func (b BiMap[K, V]) Get(k K) V { return b.values.Get(k) }
+
+// GetE returns the value mapped to k. Should k not exist, an instance of
+// errors.KeyNotFoundError is returned.
+func (b BiMap[K, V]) GetE(k K) (V, error) { return b.values.GetE(k) }
+
+// GetKey calls GetKeyE, omitting the error.
+func (b BiMap[K, V]) GetKey(v V) K { return b.keys.Get(v) }
+
+// GetKeyE returns the key mapped to v. Should v not exist, an instance of
+// errors.KeyNotFoundError is returned.
+func (b BiMap[K, V]) GetKeyE(v V) (K, error) { return b.keys.GetE(v) }
+
+// Has checks if k is mapped to a value.
+func (b BiMap[K, V]) Has(k K) bool {
+	_, ok := b.values[k]
+	return ok
+}
+
+// HasValue checks if v is mapped to a key.
+func (b BiMap[K, V]) HasValue(v V) bool {
+	_, ok := b.keys[v]
+	return ok
+}
+
+// Forget removes k and the value mapped to it.
+func (b BiMap[K, V]) Forget(k K) BiMap[K, V] {
+	if v, ok := b.values[k]; ok {
+		delete(b.values, k)
+		delete(b.keys, v)
+	}
+
+	return b
+}
+
+// ForgetValue removes v and the key mapped to it.
+func (b BiMap[K, V]) ForgetValue(v V) BiMap[K, V] {
+	if k, ok := b.keys[v]; ok {
+		delete(b.keys, v)
+		delete(b.values, k)
+	}
+
+	return b
+}
+
+// Inverse returns a view of the BiMap with keys and values swapped. Both share the
+// same storage, so changes to one are seen by the other.
+func (b BiMap[K, V]) Inverse() BiMap[V, K] {
+	return BiMap[V, K]{values: b.keys, keys: b.values}
+}
+
+// Count returns the number of key-value pairs.
+func (b BiMap[K, V]) Count() int { return len(b.values) }
+
+// IsEmpty checks if the BiMap is empty.
+func (b BiMap[K, V]) IsEmpty() bool { return len(b.values) == 0 }
+
+// Keys returns the keys of the BiMap.
+func (b BiMap[K, V]) Keys() slice.Collection[K] { return b.values.Keys() }
+
+// Values returns the values of the BiMap.
+func (b BiMap[K, V]) Values() slice.Collection[V] { return b.keys.Keys() }
+
+// Each calls f with every key-value pair.
+func (b BiMap[K, V]) Each(f func(k K, v V)) BiMap[K, V] {
+	b.values.Each(f)
+	return b
+}
+
+// ToKV returns a copy of the key-value pairs as a Collection.
+func (b BiMap[K, V]) ToKV() Collection[K, V] { return b.values.Copy() }
+
+func (b BiMap[K, V]) mustBeMade() {
+	if b.values == nil {
+		panic("kv: put into a zero BiMap, make it with NewBiMap")
+	}
+}
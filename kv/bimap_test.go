@@ -0,0 +1,124 @@
+package kv
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBiMapPutE(t *testing.T) {
+	testCases := []struct {
+		description string
+		key         string
+		value       int
+		err         string
+	}{
+		{"new pair", "c", 3, ""},
+		{"existing pair", "a", 1, ""},
+		{"key conflict", "a", 3, "key 'a' is already mapped to another value"},
+		{"value conflict", "c", 1, "value '1' is already mapped to another key"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			b := CollectBiMap(map[string]int{"a": 1, "b": 2})
+			err := b.PutE(tc.key, tc.value)
+
+			if tc.err == "" && err != nil {
+				t.Errorf("unexpected error %v", err)
+			}
+
+			if tc.err != "" {
+				if err == nil || err.Error() != tc.err {
+					t.Errorf("expected error to be %s. got %v", tc.err, err)
+				}
+
+				if expected := (Collection[string, int]{"a": 1, "b": 2}); !reflect.DeepEqual(b.ToKV(), expected) {
+					t.Errorf("expected conflicts to leave the BiMap unchanged. got %v", b.ToKV())
+				}
+			}
+		})
+	}
+}
+
+func TestCollectBiMapE(t *testing.T) {
+	b, err := CollectBiMapE(map[string]int{"a": 1, "b": 1})
+
+	if err == nil || err.Error() != "value '1' is already mapped to another key" {
+		t.Errorf("expected a value conflict error. got %v", err)
+	}
+
+	if expected := (Collection[string, int]{"a": 1}); !reflect.DeepEqual(b.ToKV(), expected) {
+		t.Errorf("expected only the first conflicting key to be kept. expected %v. got %v", expected, b.ToKV())
+	}
+
+	for i := 0; i < 20; i++ {
+		b, _ := CollectBiMapE(map[int]string{5: "x", 3: "x", 4: "y", 1: "x", 2: "y"})
+
+		if expected := (Collection[int, string]{1: "x", 2: "y"}); !reflect.DeepEqual(b.ToKV(), expected) {
+			t.Errorf("expected %v. got %v", expected, b.ToKV())
+		}
+	}
+}
+
+func TestBiMapForcePut(t *testing.T) {
+	b := CollectBiMap(map[string]int{"a": 1, "b": 2})
+	b.ForcePut("a", 2)
+
+	if expected := (Collection[string, int]{"a": 2}); !reflect.DeepEqual(b.ToKV(), expected) {
+		t.Errorf("expected %v. got %v", expected, b.ToKV())
+	}
+
+	if b.GetKey(2) != "a" || b.HasValue(1) || b.Has("b") {
+		t.Error("expected conflicting pairs to be removed from both sides")
+	}
+}
+
+func TestBiMapInverse(t *testing.T) {
+	b := NewBiMap[string, int]().Put("a", 1)
+	inverse := b.Inverse()
+
+	inverse.Put(2, "b")
+	b.Forget("a")
+
+	if expected := (Collection[int, string]{2: "b"}); !reflect.DeepEqual(inverse.ToKV(), expected) {
+		t.Errorf("expected %v. got %v", expected, inverse.ToKV())
+	}
+
+	if v, err := b.GetE("b"); err != nil || v != 2 {
+		t.Errorf("expected changes to the inverse to be seen. got %v, %v", v, err)
+	}
+
+	if _, err := inverse.GetKeyE("a"); err == nil || err.Error() != "key 'a' not found" {
+		t.Errorf("expected a key not found error. got %v", err)
+	}
+
+	b.ForgetValue(2)
+
+	if !inverse.IsEmpty() {
+		t.Errorf("expected the inverse to be empty. got %v", inverse.ToKV())
+	}
+}
+
+func TestBiMapZeroValue(t *testing.T) {
+	var b BiMap[string, int]
+
+	if b.Has("a") || b.HasValue(1) || !b.IsEmpty() || b.Count() != 0 {
+		t.Error("expected the zero value to be empty")
+	}
+
+	if _, err := b.GetE("a"); err == nil {
+		t.Error("expected a key not found error")
+	}
+
+	b.Forget("a").ForgetValue(1).Inverse().Each(func(int, string) {
+		t.Error("expected no pairs")
+	})
+
+	defer func() {
+		if r := recover(); r != "kv: put into a zero BiMap, make it with NewBiMap" {
+			t.Errorf("expected putting into the zero value to panic. got %v", r)
+		}
+	}()
+
+	b.Put("a", 1)
+}
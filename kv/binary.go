@@ -0,0 +1,28 @@
+package kv
+
+import "github.com/thefuga/go-collections/internal"
+
+// MarshalBinary implements encoding.BinaryMarshaler. The encoding is versioned.
+func (c Collection[K, V]) MarshalBinary() ([]byte, error) {
+	return internal.EncodeBinary(map[K]V(c))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, decoding data encoded by
+// MarshalBinary into the collection.
+func (c *Collection[K, V]) UnmarshalBinary(data []byte) error {
+	values := map[K]V{}
+
+	if err := internal.DecodeBinary(data, &values); err != nil {
+		return err
+	}
+
+	*c = values
+
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder by delegating to MarshalBinary.
+func (c Collection[K, V]) GobEncode() ([]byte, error) { return c.MarshalBinary() }
+
+// GobDecode implements gob.GobDecoder by delegating to UnmarshalBinary.
+func (c *Collection[K, V]) GobDecode(data []byte) error { return c.UnmarshalBinary(data) }
@@ -0,0 +1,48 @@
+package kv
+
+import (
+	"bytes"
+	"encoding/gob"
+	"reflect"
+	"testing"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	collection := Collection[string, int]{"a": 1, "b": 2}
+
+	data, err := collection.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	var decoded Collection[string, int]
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(decoded, collection) {
+		t.Errorf("expected %v. got %v", collection, decoded)
+	}
+
+	if err := decoded.UnmarshalBinary([]byte{0}); err == nil {
+		t.Error("expected an unsupported version error")
+	}
+}
+
+func TestGob(t *testing.T) {
+	collection := Collection[string, []int]{"a": {1, 2}, "b": nil}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(collection); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	var decoded Collection[string, []int]
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(decoded, collection) {
+		t.Errorf("expected %v. got %v", collection, decoded)
+	}
+}
@@ -4,6 +4,7 @@
 package kv
 
 import (
+	stdErrors "errors"
 	"reflect"
 
 	"github.com/thefuga/go-collections"
@@ -88,6 +89,35 @@ func (c Collection[K, V]) Each(f func(k K, v V)) Collection[K, V] {
 	return c
 }
 
+// EachWhile calls f with every key-value pair of the collection until f returns false.
+// Order is not guaranteed.
+func (c Collection[K, V]) EachWhile(f func(k K, v V) bool) Collection[K, V] {
+	for key, value := range c {
+		if !f(key, value) {
+			break
+		}
+	}
+
+	return c
+}
+
+// EachE calls f with every key-value pair of the collection until f returns an error.
+// Should the error be (or wrap) errors.Stop, nil is returned. Otherwise, the error is
+// returned. Order is not guaranteed.
+func (c Collection[K, V]) EachE(f func(k K, v V) error) error {
+	for key, value := range c {
+		if err := f(key, value); err != nil {
+			if stdErrors.Is(err, errors.Stop) {
+				return nil
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Get calls GetE, omitting the error.
 func (c *Collection[K, V]) Get(k K) V {
 	v, _ := c.GetE(k)
@@ -239,9 +269,20 @@ func (c Collection[K, V]) Concat(concatTo Collection[K, V]) Collection[K, V] {
 	return c
 }
 
-// Contains checks if any values on the Collection match f.
+// Contains checks if any values on the Collection match f, returning on the first
+// match. f receives the position of each value in the iteration, which is not ordered.
 func (c Collection[K, V]) Contains(f collections.Matcher[int, V]) bool {
-	return c.Values().Contains(f)
+	i := 0
+
+	for _, v := range c {
+		if f(i, v) {
+			return true
+		}
+
+		i++
+	}
+
+	return false
 }
 
 // Every checks if every value on the Collection match f.
@@ -383,3 +424,65 @@ func (c Collection[K, V]) UnlessNotEmpty(
 ) Collection[K, V] {
 	return c.WhenEmpty(f)
 }
+
+// DiffAssoc calls DiffAssocUsing, comparing values with reflect.DeepEqual.
+func (c Collection[K, V]) DiffAssoc(other Collection[K, V]) Collection[K, V] {
+	return c.DiffAssocUsing(other, collections.DeepEquals[V]())
+}
+
+// DiffAssocUsing makes a new collection containing the key-value pairs from the
+// collection which keys are not present on other or which values differ from
+// the ones on other. Values are compared with equals, which can be collections.Equals,
+// collections.DeepEquals or a custom closure.
+func (c Collection[K, V]) DiffAssocUsing(
+	other Collection[K, V], equals func(current, other V) bool,
+) Collection[K, V] {
+	return c.Filter(func(k K, v V) bool {
+		otherV, ok := other[k]
+		return !ok || !equals(v, otherV)
+	})
+}
+
+// DiffKeys makes a new collection containing the key-value pairs from the collection
+// which keys are not present on other.
+func (c Collection[K, V]) DiffKeys(other Collection[K, V]) Collection[K, V] {
+	return c.Filter(func(k K, _ V) bool {
+		_, ok := other[k]
+		return !ok
+	})
+}
+
+// IntersectByKeys makes a new collection containing the key-value pairs from the
+// collection which keys are also present on other.
+func (c Collection[K, V]) IntersectByKeys(other Collection[K, V]) Collection[K, V] {
+	return c.Filter(func(k K, _ V) bool {
+		_, ok := other[k]
+		return ok
+	})
+}
+
+// Except returns a new collection containing all key-value pairs but the ones from
+// the keys slice. It has the opposite behavior from Only.
+func (c Collection[K, V]) Except(keys []K) Collection[K, V] {
+	except := c.Copy()
+
+	for _, key := range keys {
+		except.Forget(key)
+	}
+
+	return except
+}
+
+// Union makes a new collection containing the key-value pairs from both collections.
+// Should two keys equal, the value from the receiver is preserved. Unlike Concat,
+// the receiver is left untouched.
+func (c Collection[K, V]) Union(other Collection[K, V]) Collection[K, V] {
+	return c.Copy().Concat(other)
+}
+
+// Replace makes a new collection containing the key-value pairs from both collections.
+// Should two keys equal, the value from other is used. Unlike Merge, the receiver
+// is left untouched.
+func (c Collection[K, V]) Replace(other Collection[K, V]) Collection[K, V] {
+	return c.Copy().Merge(other)
+}
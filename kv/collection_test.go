@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/thefuga/go-collections"
+	"github.com/thefuga/go-collections/errors"
 )
 
 func TestCollect(t *testing.T) {
@@ -994,3 +995,141 @@ func TestUnlessNotEmpty(t *testing.T) {
 		}
 	}
 }
+
+func TestDiffAssoc(t *testing.T) {
+	a := CollectMap(map[string][]int{"a": {1}, "b": {2}, "c": {3}})
+	b := CollectMap(map[string][]int{"a": {1}, "b": {20}})
+	expected := CollectMap(map[string][]int{"b": {2}, "c": {3}})
+
+	if diff := a.DiffAssoc(b); !reflect.DeepEqual(expected, diff) {
+		t.Errorf("expected %v. got %v", expected, diff)
+	}
+}
+
+func TestDiffAssocUsing(t *testing.T) {
+	a := CollectMap(map[string]string{"a": "foo", "b": "BAR", "c": "baz"})
+	b := CollectMap(map[string]string{"a": "foo", "b": "bar", "c": "qux"})
+	sameLength := func(current, other string) bool { return len(current) == len(other) }
+
+	testCases := []struct {
+		description string
+		equals      func(current, other string) bool
+		expected    Collection[string, string]
+	}{
+		{"using ==", collections.Equals[string](), CollectMap(map[string]string{"b": "BAR", "c": "baz"})},
+		{"using a custom comparison", sameLength, CollectMap(map[string]string{})},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if diff := a.DiffAssocUsing(b, tc.equals); !reflect.DeepEqual(tc.expected, diff) {
+				t.Errorf("expected %v. got %v", tc.expected, diff)
+			}
+		})
+	}
+}
+
+func TestDiffKeys(t *testing.T) {
+	a := CollectMap(map[string]int{"a": 1, "b": 2, "c": 3})
+	b := CollectMap(map[string]int{"a": 10, "c": 30})
+	expected := CollectMap(map[string]int{"b": 2})
+
+	if diff := a.DiffKeys(b); !reflect.DeepEqual(expected, diff) {
+		t.Errorf("expected %v. got %v", expected, diff)
+	}
+}
+
+func TestIntersectByKeys(t *testing.T) {
+	a := CollectMap(map[string]int{"a": 1, "b": 2, "c": 3})
+	b := CollectMap(map[string]int{"a": 10, "c": 30, "d": 40})
+	expected := CollectMap(map[string]int{"a": 1, "c": 3})
+
+	if intersection := a.IntersectByKeys(b); !reflect.DeepEqual(expected, intersection) {
+		t.Errorf("expected %v. got %v", expected, intersection)
+	}
+}
+
+func TestExcept(t *testing.T) {
+	collection := CollectMap(map[string]int{"a": 1, "b": 2, "c": 3})
+	expected := CollectMap(map[string]int{"b": 2})
+
+	if except := collection.Except([]string{"a", "c", "d"}); !reflect.DeepEqual(expected, except) {
+		t.Errorf("expected %v. got %v", expected, except)
+	}
+
+	if collection.Count() != 3 {
+		t.Error("the original collection must be left untouched")
+	}
+}
+
+func TestUnion(t *testing.T) {
+	a := CollectMap(map[string]int{"a": 1, "b": 2})
+	b := CollectMap(map[string]int{"b": 20, "c": 30})
+	expected := CollectMap(map[string]int{"a": 1, "b": 2, "c": 30})
+
+	if union := a.Union(b); !reflect.DeepEqual(expected, union) {
+		t.Errorf("expected %v. got %v", expected, union)
+	}
+
+	if a.Count() != 2 {
+		t.Error("the original collection must be left untouched")
+	}
+}
+
+func TestReplace(t *testing.T) {
+	a := CollectMap(map[string]int{"a": 1, "b": 2})
+	b := CollectMap(map[string]int{"b": 20, "c": 30})
+	expected := CollectMap(map[string]int{"a": 1, "b": 20, "c": 30})
+
+	if replaced := a.Replace(b); !reflect.DeepEqual(expected, replaced) {
+		t.Errorf("expected %v. got %v", expected, replaced)
+	}
+
+	if a["b"] != 2 {
+		t.Error("the original collection must be left untouched")
+	}
+}
+
+func TestEachWhile(t *testing.T) {
+	visited := 0
+
+	Collection[string, int]{"a": 1, "b": 2, "c": 3}.EachWhile(func(string, int) bool {
+		visited++
+		return visited < 2
+	})
+
+	if visited != 2 {
+		t.Errorf("expected 2 visits. got %d", visited)
+	}
+}
+
+func TestEachE(t *testing.T) {
+	visited := 0
+
+	err := Collection[string, int]{"a": 1, "b": 2, "c": 3}.EachE(func(string, int) error {
+		visited++
+		return fmt.Errorf("wrapped: %w", errors.Stop)
+	})
+
+	if err != nil || visited != 1 {
+		t.Errorf("expected no error after 1 visit. got %v after %d", err, visited)
+	}
+
+	failure := fmt.Errorf("failure")
+	if err := (Collection[string, int]{"a": 1}).EachE(func(string, int) error { return failure }); err != failure {
+		t.Errorf("expected %v. got %v", failure, err)
+	}
+}
+
+func TestContainsStopsOnFirstMatch(t *testing.T) {
+	visited := 0
+
+	contains := Collection[string, int]{"a": 1, "b": 1, "c": 1}.Contains(func(int, int) bool {
+		visited++
+		return true
+	})
+
+	if !contains || visited != 1 {
+		t.Errorf("expected true after 1 visit. got %v after %d", contains, visited)
+	}
+}
@@ -0,0 +1,121 @@
+package kv
+
+import (
+	"context"
+	stdErrors "errors"
+
+	"github.com/thefuga/go-collections/errors"
+)
+
+// EachCtx acts just like EachE, checking ctx before each key-value pair. Should ctx be
+// done, an instance of errors.InterruptedError wrapping ctx.Err() and holding the number
+// of processed pairs is returned. Order is not guaranteed.
+func (c Collection[K, V]) EachCtx(ctx context.Context, f func(k K, v V) error) error {
+	processed := 0
+
+	for key, value := range c {
+		if err := ctx.Err(); err != nil {
+			return errors.NewInterruptedError(processed, err)
+		}
+
+		if err := f(key, value); err != nil {
+			if stdErrors.Is(err, errors.Stop) {
+				return nil
+			}
+
+			return err
+		}
+
+		processed++
+	}
+
+	return nil
+}
+
+// MapCtx acts just like Map, but f may fail and ctx is checked before each pair.
+// Should f return an error or ctx be done, an empty collection and the error are
+// returned, the same way EachCtx does.
+func (c Collection[K, V]) MapCtx(ctx context.Context, f func(k K, v V) (V, error)) (Collection[K, V], error) {
+	mappedValues := make(Collection[K, V], c.Count())
+
+	err := c.EachCtx(ctx, func(k K, v V) error {
+		mapped, err := f(k, v)
+		if err == nil {
+			mappedValues[k] = mapped
+		}
+
+		return err
+	})
+
+	if err != nil {
+		return Collection[K, V]{}, err
+	}
+
+	return mappedValues, nil
+}
+
+// FilterCtx acts just like Filter, but f may fail and ctx is checked before each pair.
+// Should f return an error or ctx be done, an empty collection and the error are
+// returned, the same way EachCtx does.
+func (c Collection[K, V]) FilterCtx(ctx context.Context, f func(k K, v V) (bool, error)) (Collection[K, V], error) {
+	filtered := make(Collection[K, V])
+
+	err := c.EachCtx(ctx, func(k K, v V) error {
+		matched, err := f(k, v)
+		if matched && err == nil {
+			filtered[k] = v
+		}
+
+		return err
+	})
+
+	if err != nil {
+		return Collection[K, V]{}, err
+	}
+
+	return filtered, nil
+}
+
+// ReduceCtx reduces the collection to a single value, calling f with the carry and every
+// key-value pair. ctx is checked before each pair. Should f return an error or ctx be
+// done, the carry computed so far and the error are returned, the same way EachCtx does.
+// Order is not guaranteed.
+func (c Collection[K, V]) ReduceCtx(ctx context.Context, f func(carry V, k K, v V) (V, error), carry V) (V, error) {
+	err := c.EachCtx(ctx, func(k K, v V) error {
+		reduced, err := f(carry, k, v)
+		if err == nil {
+			carry = reduced
+		}
+
+		return err
+	})
+
+	return carry, err
+}
+
+// GroupByCtx groups the key-value pairs of c by the value returned by f, checking ctx
+// before each pair. Should ctx be done, nil and the error are returned, the same way
+// EachCtx does.
+func GroupByCtx[T comparable, K comparable, V any](
+	ctx context.Context, c Collection[K, V], f func(k K, v V) T,
+) (map[T]Collection[K, V], error) {
+	groups := make(map[T]Collection[K, V])
+
+	err := c.EachCtx(ctx, func(k K, v V) error {
+		key := f(k, v)
+
+		if _, ok := groups[key]; !ok {
+			groups[key] = make(Collection[K, V])
+		}
+
+		groups[key][k] = v
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
@@ -0,0 +1,94 @@
+package kv
+
+import (
+	"context"
+	stdErrors "errors"
+	"reflect"
+	"testing"
+
+	"github.com/thefuga/go-collections/errors"
+)
+
+func TestCtxMethods(t *testing.T) {
+	ctx := context.Background()
+	c := Collection[string, int]{"a": 1, "b": 2, "c": 3}
+
+	doubled, err := c.MapCtx(ctx, func(_ string, v int) (int, error) { return v * 2, nil })
+	if expected := (Collection[string, int]{"a": 2, "b": 4, "c": 6}); err != nil || !reflect.DeepEqual(doubled, expected) {
+		t.Errorf("expected %v. got %v, %v", expected, doubled, err)
+	}
+
+	odd, err := c.FilterCtx(ctx, func(_ string, v int) (bool, error) { return v%2 == 1, nil })
+	if expected := (Collection[string, int]{"a": 1, "c": 3}); err != nil || !reflect.DeepEqual(odd, expected) {
+		t.Errorf("expected %v. got %v, %v", expected, odd, err)
+	}
+
+	visited := 0
+	err = c.EachCtx(ctx, func(string, int) error {
+		visited++
+		return errors.Stop
+	})
+	if err != nil || visited != 1 {
+		t.Errorf("expected no error after 1 visit. got %v after %d", err, visited)
+	}
+}
+
+func TestEachCtxCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	err := Collection[string, int]{"a": 1, "b": 2, "c": 3}.EachCtx(ctx, func(string, int) error {
+		cancel()
+		return nil
+	})
+
+	if !stdErrors.Is(err, context.Canceled) {
+		t.Errorf("expected %v. got %v", context.Canceled, err)
+	}
+
+	if expected := "context canceled: interrupted after processing 1 items"; err == nil || err.Error() != expected {
+		t.Errorf("expected %q. got %v", expected, err)
+	}
+
+	if m, err := (Collection[string, int]{"a": 1}).MapCtx(ctx, func(_ string, v int) (int, error) { return v, nil }); m == nil || !m.IsEmpty() || err == nil {
+		t.Errorf("expected an empty collection and an error. got %v, %v", m, err)
+	}
+
+	if m, err := (Collection[string, int]{"a": 1}).FilterCtx(ctx, func(string, int) (bool, error) { return true, nil }); m == nil || !m.IsEmpty() || err == nil {
+		t.Errorf("expected an empty collection and an error. got %v, %v", m, err)
+	}
+
+	if groups, err := GroupByCtx(ctx, Collection[string, int]{"a": 1}, func(string, int) bool { return true }); groups != nil || err == nil {
+		t.Errorf("expected nil and an error. got %v, %v", groups, err)
+	}
+}
+
+func TestReduceAndGroupByCtx(t *testing.T) {
+	ctx := context.Background()
+	c := Collection[string, int]{"a": 1, "b": 2, "c": 3}
+
+	sum, err := c.ReduceCtx(ctx, func(carry int, _ string, v int) (int, error) { return carry + v, nil }, 0)
+	if err != nil || sum != 6 {
+		t.Errorf("expected 6. got %d, %v", sum, err)
+	}
+
+	failure := stdErrors.New("failure")
+	partial, err := c.ReduceCtx(ctx, func(carry int, k string, v int) (int, error) {
+		if k == "b" {
+			return 0, failure
+		}
+
+		return carry + v, nil
+	}, 10)
+
+	if !stdErrors.Is(err, failure) || partial < 10 {
+		t.Errorf("expected the partial carry and %v. got %d, %v", failure, partial, err)
+	}
+
+	groups, err := GroupByCtx(ctx, c, func(_ string, v int) bool { return v%2 == 0 })
+	expected := map[bool]Collection[string, int]{true: {"b": 2}, false: {"a": 1, "c": 3}}
+
+	if err != nil || !reflect.DeepEqual(groups, expected) {
+		t.Errorf("expected %v. got %v, %v", expected, groups, err)
+	}
+}
@@ -0,0 +1,51 @@
+package kv
+
+import (
+	"fmt"
+
+	"github.com/thefuga/go-collections/internal"
+)
+
+// String returns the collection formatted with %v.
+func (c Collection[K, V]) String() string { return fmt.Sprint(c) }
+
+// Format implements fmt.Formatter, printing the pairs sorted by key. %v prints the
+// pairs in a single line, %+v prints one pair per line and %#v prints the collection
+// in Go syntax. The precision limits the number of pairs printed (e.g. %.10v).
+func (c Collection[K, V]) Format(s fmt.State, verb rune) {
+	formatMap(s, verb, c, c)
+}
+
+// String returns the multimap formatted with %v.
+func (m MultiMap[K, V]) String() string { return fmt.Sprint(m) }
+
+// Format implements fmt.Formatter the same way Collection does.
+func (m MultiMap[K, V]) Format(s fmt.State, verb rune) {
+	formatMap(s, verb, m, m)
+}
+
+// String returns the bimap formatted with %v.
+func (b BiMap[K, V]) String() string { return fmt.Sprint(b) }
+
+// Format implements fmt.Formatter the same way Collection does.
+func (b BiMap[K, V]) Format(s fmt.State, verb rune) {
+	formatMap(s, verb, b, b.values)
+}
+
+func formatMap[K comparable, V any](s fmt.State, verb rune, c any, values map[K]V) {
+	keys := Collection[K, V](values).Keys()
+	internal.SortKeys(keys)
+
+	internal.Format(s, verb, c, internal.Formatter{
+		Open:  "map[",
+		Close: "]",
+		Keyed: true,
+		Each: func(f func(k, v any) bool) {
+			for _, k := range keys {
+				if !f(k, values[k]) {
+					return
+				}
+			}
+		},
+	})
+}
@@ -0,0 +1,30 @@
+package kv
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	testCases := []struct {
+		description string
+		format      string
+		collection  any
+		expected    string
+	}{
+		{"sorted keys", "%v", Collection[int, string]{10: "a", 2: "b", 1: "c"}, "map[1:c 2:b 10:a]"},
+		{"truncated", "%.1v", Collection[string, int]{"b": 2, "a": 1}, "map[a:1 ...]"},
+		{"multi line", "%+v", Collection[string, int]{"b": 2, "a": 1}, "map[\n  a: 1\n  b: 2\n]"},
+		{"go syntax", "%#v", Collection[string, int]{"a": 1}, `kv.Collection[string,int]{"a":1}`},
+		{"multimap", "%v", CollectMultiMap(map[string][]int{"b": {2}, "a": {1, 1}}), "map[a:[1 1] b:[2]]"},
+		{"bimap", "%v", CollectBiMap(map[string]int{"b": 2, "a": 1}), "map[a:1 b:2]"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if actual := fmt.Sprintf(tc.format, tc.collection); actual != tc.expected {
+				t.Errorf("expected %q. got %q", tc.expected, actual)
+			}
+		})
+	}
+}
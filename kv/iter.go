@@ -0,0 +1,52 @@
+//go:build go1.23
+
+package kv
+
+import "iter"
+
+// CollectSeq2 collects the pairs yielded by seq into a new Collection. Should a key be
+// yielded more than once, the last value is kept.
+func CollectSeq2[K comparable, V any](seq iter.Seq2[K, V]) Collection[K, V] {
+	c := Collection[K, V]{}
+
+	for k, v := range seq {
+		c[k] = v
+	}
+
+	return c
+}
+
+// All returns an iterator over the key-value pairs of the collection. Just like
+// iterating over a map, the order is not specified.
+func (c Collection[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range c {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// KeysSeq returns an iterator over the keys of the collection, in no specified order.
+func (c Collection[K, V]) KeysSeq() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range c {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over the values of the collection, in no specified
+// order.
+func (c Collection[K, V]) ValuesSeq() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range c {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,48 @@
+//go:build go1.23
+
+package kv
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestAll(t *testing.T) {
+	c := Collection[string, int]{"a": 1, "b": 2, "c": 3}
+
+	if actual := CollectSeq2(c.All()); !reflect.DeepEqual(actual, c) {
+		t.Errorf("expected %v. got %v", c, actual)
+	}
+
+	count := 0
+	for range c.All() {
+		count++
+		break
+	}
+
+	if count != 1 {
+		t.Errorf("expected to stop after 1 pair. got %d", count)
+	}
+}
+
+func TestKeysAndValuesSeq(t *testing.T) {
+	c := Collection[string, int]{"a": 1, "b": 2}
+
+	var keys []string
+	for k := range c.KeysSeq() {
+		keys = append(keys, k)
+	}
+
+	var values []int
+	for v := range c.ValuesSeq() {
+		values = append(values, v)
+	}
+
+	sort.Strings(keys)
+	sort.Ints(values)
+
+	if !reflect.DeepEqual(keys, []string{"a", "b"}) || !reflect.DeepEqual(values, []int{1, 2}) {
+		t.Errorf("expected [a b] [1 2]. got %v %v", keys, values)
+	}
+}
@@ -0,0 +1,32 @@
+//go:build go1.21
+
+package kv
+
+import (
+	"log/slog"
+
+	"github.com/thefuga/go-collections"
+	"github.com/thefuga/go-collections/internal"
+)
+
+// LogValue implements slog.LogValuer, logging the collection as a group sorted by key.
+// Up to collections.DefaultLogLimit pairs are logged and nothing is redacted. See
+// LogValueWith.
+func (c Collection[K, V]) LogValue() slog.Value {
+	return c.LogValueWith(collections.LogOptions{Limit: collections.DefaultLogLimit})
+}
+
+// LogValueWith logs the collection the same way LogValue does, using the given options
+// for limits and redaction.
+func (c Collection[K, V]) LogValueWith(options collections.LogOptions) slog.Value {
+	keys := c.Keys()
+	internal.SortKeys(keys)
+
+	return internal.LogValue(len(c), options.Limit, options.Redact, func(f func(k, v any) bool) {
+		for _, k := range keys {
+			if !f(k, c[k]) {
+				return
+			}
+		}
+	})
+}
@@ -0,0 +1,32 @@
+//go:build go1.21
+
+package kv
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/thefuga/go-collections"
+)
+
+func TestLogValue(t *testing.T) {
+	c := Collection[string, string]{"user": "foo", "token": "secret", "host": "bar"}
+
+	var buf bytes.Buffer
+	slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && a.Key != "c" {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})).Info("", "c", c.LogValueWith(collections.LogOptions{
+		Limit:  2,
+		Redact: collections.KeyEquals("token"),
+	}))
+
+	if expected := `{"c":{"host":"bar","token":"[REDACTED]","...":1}}` + "\n"; buf.String() != expected {
+		t.Errorf("expected %q. got %q", expected, buf.String())
+	}
+}
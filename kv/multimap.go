@@ -0,0 +1,145 @@
+package kv
+
+import (
+	"reflect"
+
+	"github.com/thefuga/go-collections/errors"
+	"github.com/thefuga/go-collections/slice"
+)
+
+// MultiMap is a one-to-many map, holding any number of values for each key. Just like
+// Collection, it doesn't guarantee the order of keys, but values of a key keep the
+// order they were put in.
+type MultiMap[K comparable, V any] map[K]slice.Collection[V]
+
+// CollectMultiMap returns the given map as a MultiMap.
+func CollectMultiMap[K comparable, V any](items map[K][]V) MultiMap[K, V] {
+	m := make(MultiMap[K, V], len(items))
+
+	for k, values := range items {
+		m.Put(k, values...)
+	}
+
+	return m
+}
+
+// Put appends the values to the ones held by k.
+func (m MultiMap[K, V]) Put(k K, values ...V) MultiMap[K, V] {
+	if len(values) > 0 {
+		m[k] = append(m[k], values...)
+	}
+
+	return m
+}
+
+// GetAll calls GetAllE, omitting the error.
+func (m MultiMap[K, V]) GetAll(k K) slice.Collection[V] {
+	values, _ := m.GetAllE(k)
+	return values
+}
+
+// GetAllE returns a copy of the values held by k. Should k not exist, an instance of
+// errors.KeyNotFoundError is returned.
+func (m MultiMap[K, V]) GetAllE(k K) (slice.Collection[V], error) {
+	values, ok := m[k]
+	if !ok {
+		return nil, errors.NewKeyNotFoundError(k)
+	}
+
+	return values.Copy(), nil
+}
+
+// Has checks if k holds any values.
+func (m MultiMap[K, V]) Has(k K) bool {
+	_, ok := m[k]
+	return ok
+}
+
+// Remove removes the first value held by k equal to v (compared with reflect.DeepEqual),
+// returning whether it was found. Keys left without values are removed.
+func (m MultiMap[K, V]) Remove(k K, v V) bool {
+	values := m[k]
+
+	for i := range values {
+		if reflect.DeepEqual(values[i], v) {
+			m.set(k, append(values[:i:i], values[i+1:]...))
+			return true
+		}
+	}
+
+	return false
+}
+
+// RemoveAll removes k and every value it holds, returning the removed values.
+func (m MultiMap[K, V]) RemoveAll(k K) slice.Collection[V] {
+	values := m[k]
+	delete(m, k)
+
+	return values
+}
+
+// Keys returns the keys holding values.
+func (m MultiMap[K, V]) Keys() slice.Collection[K] {
+	keys := make(slice.Collection[K], 0, len(m))
+
+	for k := range m {
+		keys = keys.Push(k)
+	}
+
+	return keys
+}
+
+// Count returns the number of values held by every key.
+func (m MultiMap[K, V]) Count() int {
+	count := 0
+
+	for _, values := range m {
+		count += len(values)
+	}
+
+	return count
+}
+
+// CountKey returns the number of values held by k.
+func (m MultiMap[K, V]) CountKey(k K) int { return len(m[k]) }
+
+// Each calls f with every key and the values it holds.
+func (m MultiMap[K, V]) Each(f func(k K, values slice.Collection[V])) MultiMap[K, V] {
+	for k, values := range m {
+		f(k, values)
+	}
+
+	return m
+}
+
+// Flatten returns every value held by every key. As keys are not ordered, neither are
+// the values of different keys.
+func (m MultiMap[K, V]) Flatten() slice.Collection[V] {
+	values := make(slice.Collection[V], 0, m.Count())
+
+	for _, v := range m {
+		values = append(values, v...)
+	}
+
+	return values
+}
+
+// ToKV returns a Collection holding a copy of the values of each key.
+func (m MultiMap[K, V]) ToKV() Collection[K, slice.Collection[V]] {
+	c := make(Collection[K, slice.Collection[V]], len(m))
+
+	for k, values := range m {
+		c.Put(k, values.Copy())
+	}
+
+	return c
+}
+
+func (m MultiMap[K, V]) set(k K, values slice.Collection[V]) {
+	if len(values) == 0 {
+		delete(m, k)
+		return
+	}
+
+	m[k] = values
+}
@@ -0,0 +1,93 @@
+package kv
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/thefuga/go-collections/slice"
+)
+
+func TestMultiMapPutAndGetAll(t *testing.T) {
+	m := CollectMultiMap(map[string][]int{"a": {1}})
+	m.Put("a", 2, 3).Put("b", 4).Put("c")
+
+	testCases := []struct {
+		description string
+		key         string
+		expected    slice.Collection[int]
+		err         string
+	}{
+		{"appended values", "a", slice.Collect(1, 2, 3), ""},
+		{"single value", "b", slice.Collect(4), ""},
+		{"no values put", "c", nil, "key 'c' not found"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			values, err := m.GetAllE(tc.key)
+
+			if !reflect.DeepEqual(values, tc.expected) {
+				t.Errorf("expected %v. got %v", tc.expected, values)
+			}
+
+			if tc.err != "" && (err == nil || err.Error() != tc.err) {
+				t.Errorf("expected error to be %s. got %v", tc.err, err)
+			}
+		})
+	}
+
+	if m.Count() != 4 || m.CountKey("a") != 3 || m.CountKey("c") != 0 {
+		t.Errorf("unexpected counts for %v", m)
+	}
+
+	m.GetAll("a")[0] = 100
+
+	if m.GetAll("a")[0] != 1 {
+		t.Error("GetAll must return a copy of the values")
+	}
+}
+
+func TestMultiMapRemove(t *testing.T) {
+	m := CollectMultiMap(map[string][]int{"a": {1, 2, 1}, "b": {3}})
+
+	if !m.Remove("a", 1) || m.Remove("a", 5) {
+		t.Error("unexpected Remove result")
+	}
+
+	if expected := slice.Collect(2, 1); !reflect.DeepEqual(m.GetAll("a"), expected) {
+		t.Errorf("expected %v. got %v", expected, m.GetAll("a"))
+	}
+
+	m.Remove("b", 3)
+
+	if m.Has("b") {
+		t.Error("keys without values must be removed")
+	}
+
+	if removed := m.RemoveAll("a"); !reflect.DeepEqual(removed, slice.Collect(2, 1)) || m.Count() != 0 {
+		t.Errorf("expected every value of a to be removed. got %v", removed)
+	}
+}
+
+func TestMultiMapKeysAndFlatten(t *testing.T) {
+	m := CollectMultiMap(map[string][]int{"a": {1, 2}, "b": {3}})
+
+	keys := m.Keys()
+	sort.Strings(keys)
+
+	if expected := slice.Collect("a", "b"); !reflect.DeepEqual(keys, expected) {
+		t.Errorf("expected %v. got %v", expected, keys)
+	}
+
+	values := m.Flatten()
+	sort.Ints(values)
+
+	if expected := slice.Collect(1, 2, 3); !reflect.DeepEqual(values, expected) {
+		t.Errorf("expected %v. got %v", expected, values)
+	}
+
+	if expected := (Collection[string, slice.Collection[int]]{"a": {1, 2}, "b": {3}}); !reflect.DeepEqual(m.ToKV(), expected) {
+		t.Errorf("expected %v. got %v", expected, m.ToKV())
+	}
+}
@@ -0,0 +1,58 @@
+package ordered
+
+import (
+	"github.com/thefuga/go-collections/errors"
+	"github.com/thefuga/go-collections/internal"
+)
+
+// binaryCollection is the encoded form of a Collection. Values are stored in key
+// order, so the map is rebuilt on decoding.
+type binaryCollection[K comparable, V any] struct {
+	Keys   []K
+	Values []V
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. The encoding is versioned and
+// preserves the order of the keys.
+func (c Collection[K, V]) MarshalBinary() ([]byte, error) {
+	encoded := binaryCollection[K, V]{
+		Keys:   c.keys,
+		Values: make([]V, 0, len(c.keys)),
+	}
+
+	for _, key := range c.keys {
+		encoded.Values = append(encoded.Values, c.values[key])
+	}
+
+	return internal.EncodeBinary(encoded)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, decoding data encoded by
+// MarshalBinary into the collection. Should the number of keys and values differ, an
+// instance of errors.InvalidEncodingError is returned.
+func (c *Collection[K, V]) UnmarshalBinary(data []byte) error {
+	var decoded binaryCollection[K, V]
+
+	if err := internal.DecodeBinary(data, &decoded); err != nil {
+		return err
+	}
+
+	if len(decoded.Keys) != len(decoded.Values) {
+		return errors.NewInvalidEncodingError()
+	}
+
+	collection := makeCollection[K, V](len(decoded.Keys))
+	for i, key := range decoded.Keys {
+		collection.Put(key, decoded.Values[i])
+	}
+
+	*c = collection
+
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder by delegating to MarshalBinary.
+func (c Collection[K, V]) GobEncode() ([]byte, error) { return c.MarshalBinary() }
+
+// GobDecode implements gob.GobDecoder by delegating to UnmarshalBinary.
+func (c *Collection[K, V]) GobDecode(data []byte) error { return c.UnmarshalBinary(data) }
@@ -0,0 +1,67 @@
+package ordered
+
+import (
+	"bytes"
+	"encoding/gob"
+	"reflect"
+	"testing"
+
+	"github.com/thefuga/go-collections/internal"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	collection := CollectMap(map[string]int{})
+	collection.Put("c", 3)
+	collection.Put("a", 1)
+	collection.Put("b", 2)
+
+	data, err := collection.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	var decoded Collection[string, int]
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(decoded.Keys(), collection.Keys()) {
+		t.Errorf("expected keys %v. got %v", collection.Keys(), decoded.Keys())
+	}
+
+	if !reflect.DeepEqual(decoded.ToSlice(), collection.ToSlice()) {
+		t.Errorf("expected values %v. got %v", collection.ToSlice(), decoded.ToSlice())
+	}
+}
+
+func TestGob(t *testing.T) {
+	type payload struct {
+		Collection Collection[int, string]
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(payload{Collect("x", "y", "z")}); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	var decoded payload
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if expected := []string{"x", "y", "z"}; !reflect.DeepEqual(decoded.Collection.ToSlice(), expected) {
+		t.Errorf("expected %v. got %v", expected, decoded.Collection.ToSlice())
+	}
+}
+
+func TestUnmarshalBinaryMismatchedLengths(t *testing.T) {
+	data, err := internal.EncodeBinary(binaryCollection[int, int]{Keys: []int{1, 2}, Values: []int{1}})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	var decoded Collection[int, int]
+	if err := decoded.UnmarshalBinary(data); err == nil || err.Error() != "invalid encoding" {
+		t.Errorf("expected invalid encoding error. got %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+package ordered
+
+import "context"
+
+// FromChan collects every value received from ch until it is closed, keyed by the
+// order in which they were received.
+func FromChan[V any](ch <-chan V) Collection[int, V] {
+	return FromChanBy(ch, sequence[V]())
+}
+
+// FromChanBy collects every value received from ch until it is closed, keyed by the
+// return value of f. Should multiple values have the same key, the last one is kept on
+// the position of the first.
+func FromChanBy[K comparable, V any](ch <-chan V, f func(v V) K) Collection[K, V] {
+	c := makeCollection[K, V](0)
+
+	for v := range ch {
+		c.Put(f(v), v)
+	}
+
+	return c
+}
+
+// CollectChan collects the values received from ch until it is closed or ctx is done,
+// keyed by the order in which they were received. Should ctx be done first, the values
+// collected so far are returned along with ctx.Err().
+func CollectChan[V any](ctx context.Context, ch <-chan V) (Collection[int, V], error) {
+	c := makeCollection[int, V](0)
+	key := sequence[V]()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return c, ctx.Err()
+		case v, ok := <-ch:
+			if !ok {
+				return c, nil
+			}
+
+			c.Put(key(v), v)
+		}
+	}
+}
+
+// ToChan returns a closed channel buffered with the values of the collection, in order.
+func (c Collection[K, V]) ToChan() <-chan V {
+	ch := make(chan V, len(c.keys))
+
+	for _, k := range c.keys {
+		ch <- c.values[k]
+	}
+
+	close(ch)
+
+	return ch
+}
+
+func sequence[V any]() func(V) int {
+	next := 0
+
+	return func(V) int {
+		next++
+		return next - 1
+	}
+}
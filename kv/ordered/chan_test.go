@@ -0,0 +1,65 @@
+package ordered
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/thefuga/go-collections/slice"
+)
+
+func TestFromChan(t *testing.T) {
+	c := FromChan(slice.Collect("a", "b", "c").ToChan())
+
+	if expected := []string{"a", "b", "c"}; !reflect.DeepEqual(c.ToSlice(), expected) {
+		t.Errorf("expected %v. got %v", expected, c.ToSlice())
+	}
+
+	if expected := "b"; c.Get(1) != expected {
+		t.Errorf("expected %v. got %v", expected, c.Get(1))
+	}
+
+	if actual := FromChan(c.ToChan()).ToSlice(); !reflect.DeepEqual(actual, c.ToSlice()) {
+		t.Errorf("expected %v. got %v", c.ToSlice(), actual)
+	}
+}
+
+func TestFromChanBy(t *testing.T) {
+	c := FromChanBy(slice.Collect("bar", "foo", "baz").ToChan(), func(v string) string {
+		return v[:1]
+	})
+
+	if expected := slice.Collect("b", "f"); !reflect.DeepEqual(c.Keys(), expected) {
+		t.Errorf("expected %v. got %v", expected, c.Keys())
+	}
+
+	if expected := "baz"; c.Get("b") != expected {
+		t.Errorf("expected %v. got %v", expected, c.Get("b"))
+	}
+}
+
+func TestCollectChan(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan string)
+
+	go func() {
+		ch <- "a"
+		cancel()
+	}()
+
+	c, err := CollectChan(ctx, ch)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected %v. got %v", context.Canceled, err)
+	}
+
+	if actual := strings.Join(c.ToSlice(), ""); actual != "a" {
+		t.Errorf("expected a. got %v", actual)
+	}
+
+	c, err = CollectChan(context.Background(), slice.Collect("x", "y").ToChan())
+	if err != nil || !reflect.DeepEqual(c.ToSlice(), []string{"x", "y"}) {
+		t.Errorf("expected [x y]. got %v, %v", c.ToSlice(), err)
+	}
+}
@@ -148,6 +148,25 @@ func (c Collection[K, V]) Each(f func(k K, v V)) Collection[K, V] {
 	return c
 }
 
+// EachWhile calls f with every key-value pair of the collection, in order, until f
+// returns false.
+func (c Collection[K, V]) EachWhile(f func(k K, v V) bool) Collection[K, V] {
+	c.keys.EachWhile(func(_ int, k K) bool {
+		return f(k, c.values[k])
+	})
+
+	return c
+}
+
+// EachE calls f with every key-value pair of the collection, in order, until f returns
+// an error. Should the error be (or wrap) errors.Stop, nil is returned. Otherwise, the
+// error is returned.
+func (c Collection[K, V]) EachE(f func(k K, v V) error) error {
+	return c.keys.EachE(func(_ int, k K) error {
+		return f(k, c.values[k])
+	})
+}
+
 // Tap passes the collection to f and returns the collection.
 func (c Collection[K, V]) Tap(f func(Collection[K, V])) Collection[K, V] {
 	f(c)
@@ -241,15 +260,16 @@ func (c Collection[K, V]) FirstOrFail(f collections.AnyMatcher) (K, V, error) {
 		k     K
 	)
 
-	// Optimize this to return on the first occurrence. Add more test scenarios!
-	c.Each(func(atK K, atV V) {
+	c.EachWhile(func(atK K, atV V) bool {
 		if !f(atK, atV) {
-			return
+			return true
 		}
 
 		found = true
 		k = atK
 		v = atV
+
+		return false
 	})
 
 	if !found {
@@ -349,11 +369,9 @@ func (c Collection[K, V]) Contains(f collections.AnyMatcher) bool {
 func (c Collection[K, V]) Every(f collections.AnyMatcher) bool {
 	contains := true
 
-	c.Each(func(k K, v V) {
-		if !f(k, v) {
-			contains = false
-			return
-		}
+	c.EachWhile(func(k K, v V) bool {
+		contains = f(k, v)
+		return contains
 	})
 
 	return contains
@@ -480,3 +498,97 @@ func (c Collection[K, V]) ForgetE(k K) (Collection[K, V], error) {
 
 	return c, nil
 }
+
+// DiffAssoc calls DiffAssocUsing, comparing values with reflect.DeepEqual.
+func (c Collection[K, V]) DiffAssoc(other Collection[K, V]) Collection[K, V] {
+	return c.DiffAssocUsing(other, collections.DeepEquals[V]())
+}
+
+// DiffAssocUsing makes a new collection containing the key-value pairs from the
+// collection which keys are not present on other or which values differ from
+// the ones on other. Values are compared with equals, which can be collections.Equals,
+// collections.DeepEquals or a custom closure. The order of the receiver is preserved.
+func (c Collection[K, V]) DiffAssocUsing(
+	other Collection[K, V], equals func(current, other V) bool,
+) Collection[K, V] {
+	return c.keep(func(k K, v V) bool {
+		otherV, ok := other.values[k]
+		return !ok || !equals(v, otherV)
+	})
+}
+
+// DiffKeys makes a new collection containing the key-value pairs from the collection
+// which keys are not present on other. The order of the receiver is preserved.
+func (c Collection[K, V]) DiffKeys(other Collection[K, V]) Collection[K, V] {
+	return c.keep(func(k K, _ V) bool {
+		_, ok := other.values[k]
+		return !ok
+	})
+}
+
+// IntersectByKeys makes a new collection containing the key-value pairs from the
+// collection which keys are also present on other. The order of the receiver is preserved.
+func (c Collection[K, V]) IntersectByKeys(other Collection[K, V]) Collection[K, V] {
+	return c.keep(func(k K, _ V) bool {
+		_, ok := other.values[k]
+		return ok
+	})
+}
+
+// Except returns a new collection containing all key-value pairs but the ones from
+// the keys slice. It has the opposite behavior from Only. The order of the receiver
+// is preserved.
+func (c Collection[K, V]) Except(keys []K) Collection[K, V] {
+	except := make(map[K]struct{}, len(keys))
+	for _, key := range keys {
+		except[key] = struct{}{}
+	}
+
+	return c.keep(func(k K, _ V) bool {
+		_, ok := except[k]
+		return !ok
+	})
+}
+
+// Union makes a new collection containing the key-value pairs from the receiver followed
+// by the ones from other which keys are not present on the receiver. Unlike Concat,
+// conflicting keys from other are discarded and the receiver is left untouched.
+func (c Collection[K, V]) Union(other Collection[K, V]) Collection[K, V] {
+	union := c.keep(func(K, V) bool { return true })
+
+	other.Each(func(k K, v V) {
+		if _, ok := union.values[k]; !ok {
+			union.Put(k, v)
+		}
+	})
+
+	return union
+}
+
+// Replace makes a new collection containing the key-value pairs from both collections.
+// Should two keys equal, the value from other is used, but the key keeps its position
+// on the receiver. Keys only present on other are appended in other's order. Unlike
+// Merge, the receiver is left untouched.
+func (c Collection[K, V]) Replace(other Collection[K, V]) Collection[K, V] {
+	replaced := c.keep(func(K, V) bool { return true })
+
+	other.Each(func(k K, v V) {
+		replaced.Put(k, v)
+	})
+
+	return replaced
+}
+
+// keep makes a new collection containing the key-value pairs matched by f, preserving
+// the order of the receiver.
+func (c Collection[K, V]) keep(f func(k K, v V) bool) Collection[K, V] {
+	kept := makeCollection[K, V](c.Count())
+
+	c.Each(func(k K, v V) {
+		if f(k, v) {
+			kept.Put(k, v)
+		}
+	})
+
+	return kept
+}
@@ -3,6 +3,7 @@ package ordered
 import (
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/thefuga/go-collections"
@@ -588,6 +589,19 @@ func TestFirstOrFail(t *testing.T) {
 		)
 	}
 
+	visited := 0
+	foundKey, foundValue, foundErr = Collect("foo", "bar", "baz").FirstOrFail(func(_ any, v any) bool {
+		visited++
+		return v != "foo"
+	})
+	if foundKey != 1 || foundValue != "bar" || foundErr != nil || visited != 2 {
+		t.Errorf(
+			"Expected %d, %s, %v after 2 visits, got %d, %s, %v after %d",
+			1, "bar", nil,
+			foundKey, foundValue, foundErr, visited,
+		)
+	}
+
 	foundKey, foundValue, foundErr = collection.FirstOrFail(collections.ValueDeepEquals[any, any]("baz"))
 	if foundKey != 0 || foundValue != "" || foundErr == nil {
 		t.Errorf(
@@ -864,3 +878,147 @@ func TestUnlessNotEmpty(t *testing.T) {
 		t.Errorf("expected %v. Got %v", expectedNewCollection, newCollection)
 	}
 }
+
+func TestSetOperations(t *testing.T) {
+	a := Collect("a", "b", "c", "d")
+	b := Collect("a", "B")
+	b.Put(4, "e")
+
+	testCases := []struct {
+		description  string
+		result       Collection[int, string]
+		expectedKeys slice.Collection[int]
+		expectedVals []string
+	}{
+		{
+			"DiffAssoc",
+			a.DiffAssoc(b),
+			slice.Collect(1, 2, 3),
+			[]string{"b", "c", "d"},
+		},
+		{
+			"DiffAssocUsing with a custom comparison",
+			a.DiffAssocUsing(b, func(current, other string) bool {
+				return strings.EqualFold(current, other)
+			}),
+			slice.Collect(2, 3),
+			[]string{"c", "d"},
+		},
+		{
+			"DiffKeys",
+			a.DiffKeys(b),
+			slice.Collect(2, 3),
+			[]string{"c", "d"},
+		},
+		{
+			"IntersectByKeys",
+			a.IntersectByKeys(b),
+			slice.Collect(0, 1),
+			[]string{"a", "b"},
+		},
+		{
+			"Except",
+			a.Except([]int{2, 0, 5}),
+			slice.Collect(1, 3),
+			[]string{"b", "d"},
+		},
+		{
+			"Union",
+			a.Union(b),
+			slice.Collect(0, 1, 2, 3, 4),
+			[]string{"a", "b", "c", "d", "e"},
+		},
+		{
+			"Replace",
+			a.Replace(b),
+			slice.Collect(0, 1, 2, 3, 4),
+			[]string{"a", "B", "c", "d", "e"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if keys := tc.result.Keys(); !reflect.DeepEqual(keys, tc.expectedKeys) {
+				t.Errorf("expected keys to be %v. got %v", tc.expectedKeys, keys)
+			}
+
+			if values := tc.result.ToSlice(); !reflect.DeepEqual(values, tc.expectedVals) {
+				t.Errorf("expected values to be %v. got %v", tc.expectedVals, values)
+			}
+		})
+	}
+
+	if values := a.ToSlice(); !reflect.DeepEqual(values, []string{"a", "b", "c", "d"}) {
+		t.Errorf("the original collection must be left untouched. got %v", values)
+	}
+}
+
+func TestReplaceKeepsReceiverOrder(t *testing.T) {
+	a := CollectSlice([]string{"x", "y"})
+	b := CollectMap(map[int]string{})
+	b.Put(1, "Y")
+	b.Put(0, "X")
+
+	replaced := a.Replace(b)
+
+	if keys := replaced.Keys(); !reflect.DeepEqual(keys, slice.Collect(0, 1)) {
+		t.Errorf("expected keys to keep the receiver order. got %v", keys)
+	}
+
+	if values := replaced.ToSlice(); !reflect.DeepEqual(values, []string{"X", "Y"}) {
+		t.Errorf("expected values to be replaced. got %v", values)
+	}
+}
+
+func TestEachWhile(t *testing.T) {
+	var keys []int
+
+	Collect("foo", "bar", "baz").EachWhile(func(k int, v string) bool {
+		keys = append(keys, k)
+		return v != "bar"
+	})
+
+	if expected := []int{0, 1}; !reflect.DeepEqual(keys, expected) {
+		t.Errorf("expected %v. got %v", expected, keys)
+	}
+}
+
+func TestEachE(t *testing.T) {
+	var keys []int
+
+	err := Collect("foo", "bar", "baz").EachE(func(k int, v string) error {
+		keys = append(keys, k)
+
+		if v == "bar" {
+			return errors.Stop
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	if expected := []int{0, 1}; !reflect.DeepEqual(keys, expected) {
+		t.Errorf("expected %v. got %v", expected, keys)
+	}
+
+	failure := errors.NewValueNotFoundError()
+	if err := Collect(1).EachE(func(int, int) error { return failure }); err != failure {
+		t.Errorf("expected %v. got %v", failure, err)
+	}
+}
+
+func TestEveryStopsOnFirstMismatch(t *testing.T) {
+	visited := 0
+
+	every := Collect(1, 2, 3).Every(func(_ any, v any) bool {
+		visited++
+		return v.(int) < 2
+	})
+
+	if every || visited != 2 {
+		t.Errorf("expected false after 2 visits. got %v after %d", every, visited)
+	}
+}
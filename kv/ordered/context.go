@@ -0,0 +1,101 @@
+package ordered
+
+import "context"
+
+// EachCtx calls f with every key-value pair of the collection, in order, the same way
+// the generic EachCtx function does.
+func (c Collection[K, V]) EachCtx(ctx context.Context, f func(k K, v V) error) error {
+	return c.keys.EachCtx(ctx, func(_ int, k K) error {
+		return f(k, c.values[k])
+	})
+}
+
+// MapCtx acts just like Map, but f may fail and ctx is checked before each pair.
+// Should f return an error or ctx be done, an empty collection and the error are
+// returned, the same way EachCtx does.
+func (c Collection[K, V]) MapCtx(ctx context.Context, f func(k K, v V) (V, error)) (Collection[K, V], error) {
+	mappedValues := makeCollection[K, V](c.Count())
+
+	err := c.EachCtx(ctx, func(k K, v V) error {
+		mapped, err := f(k, v)
+		if err == nil {
+			mappedValues.Put(k, mapped)
+		}
+
+		return err
+	})
+
+	if err != nil {
+		return makeCollection[K, V](0), err
+	}
+
+	return mappedValues, nil
+}
+
+// FilterCtx makes a new collection containing only the key-value pairs matched by f,
+// preserving their order. ctx is checked before each pair. Should f return an error or
+// ctx be done, an empty collection and the error are returned, the same way EachCtx
+// does.
+func (c Collection[K, V]) FilterCtx(ctx context.Context, f func(k K, v V) (bool, error)) (Collection[K, V], error) {
+	filtered := makeCollection[K, V](0)
+
+	err := c.EachCtx(ctx, func(k K, v V) error {
+		matched, err := f(k, v)
+		if matched && err == nil {
+			filtered.Put(k, v)
+		}
+
+		return err
+	})
+
+	if err != nil {
+		return makeCollection[K, V](0), err
+	}
+
+	return filtered, nil
+}
+
+// ReduceCtx reduces the collection to a single value, calling f with the carry and every
+// key-value pair, in order. ctx is checked before each pair. Should f return an error or
+// ctx be done, the carry computed so far and the error are returned, the same way EachCtx
+// does.
+func (c Collection[K, V]) ReduceCtx(ctx context.Context, f func(carry V, k K, v V) (V, error), carry V) (V, error) {
+	err := c.EachCtx(ctx, func(k K, v V) error {
+		reduced, err := f(carry, k, v)
+		if err == nil {
+			carry = reduced
+		}
+
+		return err
+	})
+
+	return carry, err
+}
+
+// GroupByCtx groups the key-value pairs of c by the value returned by f, preserving their
+// order within each group. ctx is checked before each pair. Should ctx be done, nil and
+// the error are returned, the same way EachCtx does.
+func GroupByCtx[T comparable, K comparable, V any](
+	ctx context.Context, c Collection[K, V], f func(k K, v V) T,
+) (map[T]Collection[K, V], error) {
+	groups := make(map[T]Collection[K, V])
+
+	err := c.EachCtx(ctx, func(k K, v V) error {
+		key := f(k, v)
+
+		group, ok := groups[key]
+		if !ok {
+			group = makeCollection[K, V](0)
+		}
+
+		groups[key] = group.Put(k, v)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
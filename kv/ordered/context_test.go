@@ -0,0 +1,82 @@
+package ordered
+
+import (
+	"context"
+	stdErrors "errors"
+	"reflect"
+	"testing"
+)
+
+func TestCtxMethods(t *testing.T) {
+	ctx := context.Background()
+	c := CollectMap(map[string]int{})
+	c.Put("c", 3)
+	c.Put("a", 1)
+	c.Put("b", 2)
+
+	doubled, err := c.MapCtx(ctx, func(_ string, v int) (int, error) { return v * 2, nil })
+	if expected := []int{6, 2, 4}; err != nil || !reflect.DeepEqual(doubled.ToSlice(), expected) {
+		t.Errorf("expected %v. got %v, %v", expected, doubled.ToSlice(), err)
+	}
+
+	odd, err := c.FilterCtx(ctx, func(_ string, v int) (bool, error) { return v%2 == 1, nil })
+	if expected := []string{"c", "a"}; err != nil || !reflect.DeepEqual([]string(odd.Keys()), expected) {
+		t.Errorf("expected %v. got %v, %v", expected, odd.Keys(), err)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var keys []string
+	err = c.EachCtx(canceled, func(k string, _ int) error {
+		keys = append(keys, k)
+		cancel()
+		return nil
+	})
+
+	if !stdErrors.Is(err, context.Canceled) || !reflect.DeepEqual(keys, []string{"c"}) {
+		t.Errorf("expected [c] and %v. got %v, %v", context.Canceled, keys, err)
+	}
+
+	if filtered, err := c.FilterCtx(canceled, func(string, int) (bool, error) { return true, nil }); err == nil || !filtered.IsEmpty() {
+		t.Errorf("expected an empty collection and an error. got %v, %v", filtered, err)
+	}
+	if mapped, err := c.MapCtx(canceled, func(_ string, v int) (int, error) { return v, nil }); err == nil || !mapped.IsEmpty() {
+		t.Errorf("expected an empty collection and an error. got %v, %v", mapped, err)
+	}
+
+	if groups, err := GroupByCtx(canceled, c, func(string, int) bool { return true }); err == nil || groups != nil {
+		t.Errorf("expected nil and an error. got %v, %v", groups, err)
+	}
+}
+
+func TestReduceAndGroupByCtx(t *testing.T) {
+	ctx := context.Background()
+	c := CollectMap(map[string]int{})
+	c.Put("c", 3)
+	c.Put("a", 1)
+	c.Put("b", 2)
+
+	var visited []string
+	sum, err := c.ReduceCtx(ctx, func(carry int, k string, v int) (int, error) {
+		visited = append(visited, k)
+		return carry + v, nil
+	}, 0)
+
+	if err != nil || sum != 6 || !reflect.DeepEqual(visited, []string{"c", "a", "b"}) {
+		t.Errorf("expected 6 after visiting [c a b]. got %d after %v, %v", sum, visited, err)
+	}
+
+	groups, err := GroupByCtx(ctx, c, func(_ string, v int) bool { return v%2 == 1 })
+	if err != nil || len(groups) != 2 {
+		t.Fatalf("expected 2 groups. got %v, %v", groups, err)
+	}
+
+	if expected := []string{"c", "a"}; !reflect.DeepEqual([]string(groups[true].Keys()), expected) {
+		t.Errorf("expected %v. got %v", expected, groups[true].Keys())
+	}
+
+	if expected := []int{2}; !reflect.DeepEqual(groups[false].ToSlice(), expected) {
+		t.Errorf("expected %v. got %v", expected, groups[false].ToSlice())
+	}
+}
@@ -20,13 +20,13 @@ func ExampleCollectMap() {
 func ExampleCollectSlice() {
 	fmt.Printf("%v", CollectSlice([]int{123, 456}))
 	// Output:
-	// {[0 1] map[0:123 1:456]}
+	// {0:123 1:456}
 }
 
 func ExampleCollect() {
 	fmt.Printf("%v", Collect([]int{123, 456}))
 	// Output:
-	// {[0] map[0:[123 456]]}
+	// {0:[123 456]}
 }
 
 func ExampleCollection_Get() {
@@ -135,7 +135,7 @@ func ExampleCollection_Only() {
 
 	fmt.Printf("%v", c.Only([]string{"foo"}))
 	// Output:
-	// {[foo] map[foo:123]}
+	// {foo:123}
 }
 
 func ExampleCollection_First() {
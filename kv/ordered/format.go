@@ -0,0 +1,29 @@
+package ordered
+
+import (
+	"fmt"
+
+	"github.com/thefuga/go-collections/internal"
+)
+
+// String returns the collection formatted with %v.
+func (c Collection[K, V]) String() string { return fmt.Sprint(c) }
+
+// Format implements fmt.Formatter, printing the pairs in the collection order. %v
+// prints the pairs in a single line, %+v prints one pair per line and %#v prints the
+// collection in Go syntax. The precision limits the number of pairs printed
+// (e.g. %.10v).
+func (c Collection[K, V]) Format(s fmt.State, verb rune) {
+	internal.Format(s, verb, c, internal.Formatter{
+		Open:  "{",
+		Close: "}",
+		Keyed: true,
+		Each: func(f func(k, v any) bool) {
+			for _, k := range c.keys {
+				if !f(k, c.values[k]) {
+					return
+				}
+			}
+		},
+	})
+}
@@ -0,0 +1,36 @@
+package ordered
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	collection := CollectMap(map[string]int{})
+	collection.Put("b", 2)
+	collection.Put("a", 1)
+	collection.Put("c", 3)
+
+	testCases := []struct {
+		description string
+		format      string
+		expected    string
+	}{
+		{"insertion order", "%v", "{b:2 a:1 c:3}"},
+		{"truncated", "%.2v", "{b:2 a:1 ...}"},
+		{"multi line", "%+v", "{\n  b: 2\n  a: 1\n  c: 3\n}"},
+		{"go syntax", "%#v", `ordered.Collection[string,int]{"b":2, "a":1, "c":3}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if actual := fmt.Sprintf(tc.format, collection); actual != tc.expected {
+				t.Errorf("expected %q. got %q", tc.expected, actual)
+			}
+		})
+	}
+
+	if actual := collection.String(); actual != "{b:2 a:1 c:3}" {
+		t.Errorf("expected %q. got %q", "{b:2 a:1 c:3}", actual)
+	}
+}
@@ -0,0 +1,75 @@
+//go:build go1.23
+
+package ordered
+
+import "iter"
+
+// CollectSeq collects the values yielded by seq into a new Collection, keyed by the
+// order in which they were yielded.
+func CollectSeq[V any](seq iter.Seq[V]) Collection[int, V] {
+	c := makeCollection[int, V](0)
+
+	for v := range seq {
+		c.Put(len(c.keys), v)
+	}
+
+	return c
+}
+
+// CollectSeq2 collects the pairs yielded by seq into a new Collection, preserving the
+// order in which the keys were first yielded. Should a key be yielded more than once,
+// the last value is kept.
+func CollectSeq2[K comparable, V any](seq iter.Seq2[K, V]) Collection[K, V] {
+	c := makeCollection[K, V](0)
+
+	for k, v := range seq {
+		c.Put(k, v)
+	}
+
+	return c
+}
+
+// All returns an iterator over the key-value pairs of the collection, in order.
+func (c Collection[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, k := range c.keys {
+			if !yield(k, c.values[k]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the key-value pairs of the collection, from the
+// last to the first.
+func (c Collection[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for i := len(c.keys) - 1; i >= 0; i-- {
+			if !yield(c.keys[i], c.values[c.keys[i]]) {
+				return
+			}
+		}
+	}
+}
+
+// KeysSeq returns an iterator over the keys of the collection, in order.
+func (c Collection[K, V]) KeysSeq() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for _, k := range c.keys {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over the values of the collection, in order.
+func (c Collection[K, V]) ValuesSeq() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, k := range c.keys {
+			if !yield(c.values[k]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,55 @@
+//go:build go1.23
+
+package ordered
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIterators(t *testing.T) {
+	c := CollectMap(map[string]int{})
+	c.Put("b", 2)
+	c.Put("a", 1)
+	c.Put("c", 3)
+
+	var keys []string
+	for k, v := range c.All() {
+		if v == 3 {
+			break
+		}
+
+		keys = append(keys, k)
+	}
+
+	if expected := []string{"b", "a"}; !reflect.DeepEqual(keys, expected) {
+		t.Errorf("expected %v. got %v", expected, keys)
+	}
+
+	keys = nil
+	for k := range c.Backward() {
+		keys = append(keys, k)
+	}
+
+	if expected := []string{"c", "a", "b"}; !reflect.DeepEqual(keys, expected) {
+		t.Errorf("expected %v. got %v", expected, keys)
+	}
+
+	if actual := CollectSeq2(c.All()); !reflect.DeepEqual(actual, c) {
+		t.Errorf("expected %v. got %v", c, actual)
+	}
+
+	values := CollectSeq(c.ValuesSeq())
+	if expected := []int{2, 1, 3}; !reflect.DeepEqual(values.ToSlice(), expected) {
+		t.Errorf("expected %v. got %v", expected, values.ToSlice())
+	}
+
+	keys = nil
+	for k := range c.KeysSeq() {
+		keys = append(keys, k)
+	}
+
+	if expected := []string{"b", "a", "c"}; !reflect.DeepEqual(keys, expected) {
+		t.Errorf("expected %v. got %v", expected, keys)
+	}
+}
@@ -0,0 +1,29 @@
+//go:build go1.21
+
+package ordered
+
+import (
+	"log/slog"
+
+	"github.com/thefuga/go-collections"
+	"github.com/thefuga/go-collections/internal"
+)
+
+// LogValue implements slog.LogValuer, logging the collection as a group in the
+// collection order. Up to collections.DefaultLogLimit pairs are logged and nothing is
+// redacted. See LogValueWith.
+func (c Collection[K, V]) LogValue() slog.Value {
+	return c.LogValueWith(collections.LogOptions{Limit: collections.DefaultLogLimit})
+}
+
+// LogValueWith logs the collection the same way LogValue does, using the given options
+// for limits and redaction.
+func (c Collection[K, V]) LogValueWith(options collections.LogOptions) slog.Value {
+	return internal.LogValue(len(c.keys), options.Limit, options.Redact, func(f func(k, v any) bool) {
+		for _, k := range c.keys {
+			if !f(k, c.values[k]) {
+				return
+			}
+		}
+	})
+}
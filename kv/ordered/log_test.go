@@ -0,0 +1,25 @@
+//go:build go1.21
+
+package ordered
+
+import (
+	"log/slog"
+	"reflect"
+	"testing"
+
+	"github.com/thefuga/go-collections"
+)
+
+func TestLogValue(t *testing.T) {
+	c := CollectMap(map[string]int{})
+	c.Put("b", 2)
+	c.Put("a", 1)
+	c.Put("c", 3)
+
+	value := c.LogValueWith(collections.LogOptions{Limit: 2})
+	expected := []slog.Attr{slog.Int("b", 2), slog.Int("a", 1), slog.Int("...", 1)}
+
+	if value.Kind() != slog.KindGroup || !reflect.DeepEqual(value.Group(), expected) {
+		t.Errorf("expected %v. got %v", expected, value)
+	}
+}
@@ -151,3 +151,21 @@ func TestDuplicates(t *testing.T) {
 		})
 	}
 }
+
+func TestBinaryRoundTrip(t *testing.T) {
+	collection := Collect(3, 1, 2)
+
+	data, err := collection.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	var decoded Collection[int, int]
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if decoded.Sum() != 6 || !reflect.DeepEqual(decoded.ToSlice(), collection.ToSlice()) {
+		t.Errorf("expected %v. got %v", collection.ToSlice(), decoded.ToSlice())
+	}
+}
@@ -0,0 +1,440 @@
+// Package sorted provides a map collection sorted by key, backed by a balanced tree.
+package sorted
+
+import (
+	"github.com/thefuga/go-collections/errors"
+	"github.com/thefuga/go-collections/internal"
+	"github.com/thefuga/go-collections/kv/ordered"
+	"github.com/thefuga/go-collections/slice"
+)
+
+// Entry is a key-value pair of a SortedMap.
+type Entry[K internal.Relational, V any] struct {
+	Key   K
+	Value V
+}
+
+// SortedMap is a map which keeps its keys sorted in ascending order. It is implemented
+// as a left-leaning red-black tree, so Get, Put, Forget, and every navigation and
+// rank query run in O(log n). NaN keys are equal to each other and lower than every
+// other key. The zero value is an empty map ready to use.
+type SortedMap[K internal.Relational, V any] struct {
+	root *node[K, V]
+}
+
+// New makes an empty SortedMap.
+func New[K internal.Relational, V any]() *SortedMap[K, V] {
+	return &SortedMap[K, V]{}
+}
+
+// CollectMap makes a SortedMap holding the key-value pairs of the given map.
+func CollectMap[K internal.Relational, V any](items map[K]V) *SortedMap[K, V] {
+	m := New[K, V]()
+
+	for k, v := range items {
+		m.Put(k, v)
+	}
+
+	return m
+}
+
+// FromOrdered makes a SortedMap holding the key-value pairs of the ordered collection.
+func FromOrdered[K internal.Relational, V any](c ordered.Collection[K, V]) *SortedMap[K, V] {
+	m := New[K, V]()
+
+	c.Each(func(k K, v V) {
+		m.Put(k, v)
+	})
+
+	return m
+}
+
+// Count returns the number of key-value pairs on the map.
+func (m *SortedMap[K, V]) Count() int { return size(m.root) }
+
+// IsEmpty checks if the map is empty.
+func (m *SortedMap[K, V]) IsEmpty() bool { return m.root == nil }
+
+// Put associates v to k, replacing any value k held.
+func (m *SortedMap[K, V]) Put(k K, v V) *SortedMap[K, V] {
+	m.root, _ = put(m.root, k, v)
+	m.root.red = false
+
+	return m
+}
+
+// Get calls GetE, omitting the error.
+func (m *SortedMap[K, V]) Get(k K) V {
+	v, _ := m.GetE(k)
+	return v
+}
+
+// GetE returns the value associated to k. Should k not exist, an instance of
+// errors.KeyNotFoundError is returned.
+func (m *SortedMap[K, V]) GetE(k K) (V, error) {
+	for n := m.root; n != nil; {
+		switch c := internal.Compare(k, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.value, nil
+		}
+	}
+
+	return *new(V), errors.NewKeyNotFoundError(k)
+}
+
+// Has checks if k exists on the map.
+func (m *SortedMap[K, V]) Has(k K) bool {
+	_, err := m.GetE(k)
+	return err == nil
+}
+
+// Forget calls ForgetE, omitting the error.
+func (m *SortedMap[K, V]) Forget(k K) *SortedMap[K, V] {
+	_ = m.ForgetE(k)
+	return m
+}
+
+// ForgetE removes k from the map. Should k not exist, an instance of
+// errors.KeyNotFoundError is returned.
+func (m *SortedMap[K, V]) ForgetE(k K) error {
+	if !m.Has(k) {
+		return errors.NewKeyNotFoundError(k)
+	}
+
+	if !isRed(m.root.left) && !isRed(m.root.right) {
+		m.root.red = true
+	}
+
+	m.root = remove(m.root, k)
+	if m.root != nil {
+		m.root.red = false
+	}
+
+	return nil
+}
+
+// First calls FirstE, omitting the error.
+func (m *SortedMap[K, V]) First() Entry[K, V] {
+	e, _ := m.FirstE()
+	return e
+}
+
+// FirstE returns the entry with the lowest key. Should the map be empty, an instance
+// of errors.EmptyCollectionError is returned.
+func (m *SortedMap[K, V]) FirstE() (Entry[K, V], error) {
+	if m.root == nil {
+		return Entry[K, V]{}, errors.NewEmptyCollectionError(errors.NewValueNotFoundError())
+	}
+
+	return entryOf(minimum(m.root)), nil
+}
+
+// Last calls LastE, omitting the error.
+func (m *SortedMap[K, V]) Last() Entry[K, V] {
+	e, _ := m.LastE()
+	return e
+}
+
+// LastE returns the entry with the highest key. Should the map be empty, an instance
+// of errors.EmptyCollectionError is returned.
+func (m *SortedMap[K, V]) LastE() (Entry[K, V], error) {
+	if m.root == nil {
+		return Entry[K, V]{}, errors.NewEmptyCollectionError(errors.NewValueNotFoundError())
+	}
+
+	return entryOf(maximum(m.root)), nil
+}
+
+// Floor calls FloorE, omitting the error.
+func (m *SortedMap[K, V]) Floor(k K) Entry[K, V] {
+	e, _ := m.FloorE(k)
+	return e
+}
+
+// FloorE returns the entry with the highest key lower than or equal to k. Should there
+// be none, an instance of errors.ValueNotFoundError is returned.
+func (m *SortedMap[K, V]) FloorE(k K) (Entry[K, V], error) {
+	return m.below(k, true)
+}
+
+// Lower calls LowerE, omitting the error.
+func (m *SortedMap[K, V]) Lower(k K) Entry[K, V] {
+	e, _ := m.LowerE(k)
+	return e
+}
+
+// LowerE returns the entry with the highest key strictly lower than k. Should there
+// be none, an instance of errors.ValueNotFoundError is returned.
+func (m *SortedMap[K, V]) LowerE(k K) (Entry[K, V], error) {
+	return m.below(k, false)
+}
+
+// Ceiling calls CeilingE, omitting the error.
+func (m *SortedMap[K, V]) Ceiling(k K) Entry[K, V] {
+	e, _ := m.CeilingE(k)
+	return e
+}
+
+// CeilingE returns the entry with the lowest key greater than or equal to k. Should
+// there be none, an instance of errors.ValueNotFoundError is returned.
+func (m *SortedMap[K, V]) CeilingE(k K) (Entry[K, V], error) {
+	return m.above(k, true)
+}
+
+// Higher calls HigherE, omitting the error.
+func (m *SortedMap[K, V]) Higher(k K) Entry[K, V] {
+	e, _ := m.HigherE(k)
+	return e
+}
+
+// HigherE returns the entry with the lowest key strictly greater than k. Should there
+// be none, an instance of errors.ValueNotFoundError is returned.
+func (m *SortedMap[K, V]) HigherE(k K) (Entry[K, V], error) {
+	return m.above(k, false)
+}
+
+// Rank returns the number of keys strictly lower than k.
+func (m *SortedMap[K, V]) Rank(k K) int {
+	rank := 0
+
+	for n := m.root; n != nil; {
+		switch c := internal.Compare(k, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			rank += size(n.left) + 1
+			n = n.right
+		default:
+			return rank + size(n.left)
+		}
+	}
+
+	return rank
+}
+
+// Select calls SelectE, omitting the error.
+func (m *SortedMap[K, V]) Select(i int) Entry[K, V] {
+	e, _ := m.SelectE(i)
+	return e
+}
+
+// SelectE returns the entry with the i-th lowest key, starting at 0. Should i be out
+// of bounds, an instance of errors.IndexOutOfBoundsError is returned.
+func (m *SortedMap[K, V]) SelectE(i int) (Entry[K, V], error) {
+	if i < 0 || i >= m.Count() {
+		return Entry[K, V]{}, errors.NewIndexOutOfBoundsError(errors.NewValueNotFoundError())
+	}
+
+	n := m.root
+	for {
+		switch left := size(n.left); {
+		case i < left:
+			n = n.left
+		case i > left:
+			i -= left + 1
+			n = n.right
+		default:
+			return entryOf(n), nil
+		}
+	}
+}
+
+// Range returns an ordered collection holding the entries with keys from from
+// (inclusive) to to (exclusive), sorted in ascending order.
+func (m *SortedMap[K, V]) Range(from, to K) ordered.Collection[K, V] {
+	c := ordered.CollectMap(map[K]V{})
+
+	inOrder(m.root, &from, &to, func(n *node[K, V]) {
+		c.Put(n.key, n.value)
+	})
+
+	return c
+}
+
+// Each calls f with every key-value pair, in ascending key order.
+func (m *SortedMap[K, V]) Each(f func(k K, v V)) *SortedMap[K, V] {
+	inOrder(m.root, nil, nil, func(n *node[K, V]) {
+		f(n.key, n.value)
+	})
+
+	return m
+}
+
+// EachDesc calls f with every key-value pair, in descending key order.
+func (m *SortedMap[K, V]) EachDesc(f func(k K, v V)) *SortedMap[K, V] {
+	reverseOrder(m.root, func(n *node[K, V]) {
+		f(n.key, n.value)
+	})
+
+	return m
+}
+
+// Keys returns the keys of the map in ascending order.
+func (m *SortedMap[K, V]) Keys() slice.Collection[K] {
+	keys := make(slice.Collection[K], 0, m.Count())
+
+	m.Each(func(k K, _ V) {
+		keys = keys.Push(k)
+	})
+
+	return keys
+}
+
+// Values returns the values of the map in ascending key order.
+func (m *SortedMap[K, V]) Values() slice.Collection[V] {
+	values := make(slice.Collection[V], 0, m.Count())
+
+	m.Each(func(_ K, v V) {
+		values = values.Push(v)
+	})
+
+	return values
+}
+
+// ToOrdered returns an ordered collection holding every entry in ascending key order.
+func (m *SortedMap[K, V]) ToOrdered() ordered.Collection[K, V] {
+	c := ordered.CollectMap(make(map[K]V, m.Count()))
+
+	m.Each(func(k K, v V) {
+		c.Put(k, v)
+	})
+
+	return c
+}
+
+// Filter returns a new SortedMap holding only the key-value pairs matched by f.
+func (m *SortedMap[K, V]) Filter(f func(k K, v V) bool) *SortedMap[K, V] {
+	filtered := New[K, V]()
+
+	m.Each(func(k K, v V) {
+		if f(k, v) {
+			filtered.Put(k, v)
+		}
+	})
+
+	return filtered
+}
+
+// Reject returns a new SortedMap holding only the key-value pairs not matched by f.
+func (m *SortedMap[K, V]) Reject(f func(k K, v V) bool) *SortedMap[K, V] {
+	return m.Filter(func(k K, v V) bool {
+		return !f(k, v)
+	})
+}
+
+// When calls f with the map when execute is true.
+func (m *SortedMap[K, V]) When(
+	execute bool, f func(m *SortedMap[K, V]) *SortedMap[K, V],
+) *SortedMap[K, V] {
+	if !execute {
+		return m
+	}
+
+	return f(m)
+}
+
+// WhenEmpty calls f with the map when the map is empty.
+func (m *SortedMap[K, V]) WhenEmpty(f func(m *SortedMap[K, V]) *SortedMap[K, V]) *SortedMap[K, V] {
+	return m.When(m.IsEmpty(), f)
+}
+
+// WhenNotEmpty calls f with the map when the map is not empty.
+func (m *SortedMap[K, V]) WhenNotEmpty(f func(m *SortedMap[K, V]) *SortedMap[K, V]) *SortedMap[K, V] {
+	return m.When(!m.IsEmpty(), f)
+}
+
+// Unless calls f with the map when execute is false.
+func (m *SortedMap[K, V]) Unless(
+	execute bool, f func(m *SortedMap[K, V]) *SortedMap[K, V],
+) *SortedMap[K, V] {
+	return m.When(!execute, f)
+}
+
+// UnlessEmpty calls f with the map when the map is not empty.
+func (m *SortedMap[K, V]) UnlessEmpty(f func(m *SortedMap[K, V]) *SortedMap[K, V]) *SortedMap[K, V] {
+	return m.WhenNotEmpty(f)
+}
+
+// UnlessNotEmpty calls f with the map when the map is empty.
+func (m *SortedMap[K, V]) UnlessNotEmpty(f func(m *SortedMap[K, V]) *SortedMap[K, V]) *SortedMap[K, V] {
+	return m.WhenEmpty(f)
+}
+
+func (m *SortedMap[K, V]) below(k K, inclusive bool) (Entry[K, V], error) {
+	var found *node[K, V]
+
+	for n := m.root; n != nil; {
+		if c := internal.Compare(n.key, k); c < 0 || (inclusive && c == 0) {
+			found = n
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+
+	if found == nil {
+		return Entry[K, V]{}, errors.NewValueNotFoundError()
+	}
+
+	return entryOf(found), nil
+}
+
+func (m *SortedMap[K, V]) above(k K, inclusive bool) (Entry[K, V], error) {
+	var found *node[K, V]
+
+	for n := m.root; n != nil; {
+		if c := internal.Compare(n.key, k); c > 0 || (inclusive && c == 0) {
+			found = n
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+
+	if found == nil {
+		return Entry[K, V]{}, errors.NewValueNotFoundError()
+	}
+
+	return entryOf(found), nil
+}
+
+// inOrder visits the nodes in ascending order, skipping the subtrees out of the
+// [from, to) bounds. Nil bounds are unbounded.
+func inOrder[K internal.Relational, V any](n *node[K, V], from, to *K, f func(n *node[K, V])) {
+	if n == nil {
+		return
+	}
+
+	afterFrom := from == nil || internal.Compare(n.key, *from) >= 0
+	beforeTo := to == nil || internal.Compare(n.key, *to) < 0
+
+	if afterFrom {
+		inOrder(n.left, from, to, f)
+	}
+
+	if afterFrom && beforeTo {
+		f(n)
+	}
+
+	if beforeTo {
+		inOrder(n.right, from, to, f)
+	}
+}
+
+func reverseOrder[K internal.Relational, V any](n *node[K, V], f func(n *node[K, V])) {
+	if n == nil {
+		return
+	}
+
+	reverseOrder(n.right, f)
+	f(n)
+	reverseOrder(n.left, f)
+}
+
+func entryOf[K internal.Relational, V any](n *node[K, V]) Entry[K, V] {
+	return Entry[K, V]{Key: n.key, Value: n.value}
+}
@@ -0,0 +1,258 @@
+package sorted
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/thefuga/go-collections/kv/ordered"
+	"github.com/thefuga/go-collections/slice"
+)
+
+// checkInvariants checks the red-black tree invariants and the subtree sizes,
+// returning the black height of n.
+func checkInvariants[K int, V any](t *testing.T, n *node[K, V]) int {
+	t.Helper()
+
+	if n == nil {
+		return 1
+	}
+
+	if isRed(n.right) {
+		t.Fatalf("right leaning red link at %v", n.key)
+	}
+
+	if isRed(n) && isRed(n.left) {
+		t.Fatalf("two red links in a row at %v", n.key)
+	}
+
+	if n.size != size(n.left)+size(n.right)+1 {
+		t.Fatalf("wrong size at %v", n.key)
+	}
+
+	left, right := checkInvariants(t, n.left), checkInvariants(t, n.right)
+	if left != right {
+		t.Fatalf("unbalanced black height at %v", n.key)
+	}
+
+	if !isRed(n) {
+		left++
+	}
+
+	return left
+}
+
+func TestPutAndForget(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	m := New[int, int]()
+	expected := map[int]int{}
+
+	for i := 0; i < 5000; i++ {
+		k := random.Intn(1000)
+
+		if random.Intn(3) == 0 {
+			err := m.ForgetE(k)
+			if _, ok := expected[k]; ok == (err != nil) {
+				t.Fatalf("unexpected error forgetting %d: %v", k, err)
+			}
+			delete(expected, k)
+		} else {
+			m.Put(k, i)
+			expected[k] = i
+		}
+
+		if m.root != nil && m.root.red {
+			t.Fatal("the root must be black")
+		}
+
+		checkInvariants(t, m.root)
+	}
+
+	if m.Count() != len(expected) {
+		t.Fatalf("expected count to be %d. got %d", len(expected), m.Count())
+	}
+
+	keys := make([]int, 0, len(expected))
+	for k, v := range expected {
+		keys = append(keys, k)
+
+		if m.Get(k) != v {
+			t.Fatalf("expected %d to hold %d. got %d", k, v, m.Get(k))
+		}
+	}
+
+	sort.Ints(keys)
+
+	if !reflect.DeepEqual([]int(m.Keys()), keys) {
+		t.Error("expected keys to be sorted")
+	}
+
+	for _, k := range keys {
+		m.Forget(k)
+	}
+
+	if !m.IsEmpty() {
+		t.Errorf("expected the map to be empty. got %d elements", m.Count())
+	}
+}
+
+func TestNaNKeys(t *testing.T) {
+	m := New[float64, string]().Put(1, "one").Put(math.NaN(), "nan").Put(2, "two").Put(math.NaN(), "other nan")
+
+	if m.Count() != 3 || m.Get(1) != "one" || m.Get(2) != "two" {
+		t.Errorf("expected NaN not to replace other keys. got %v", m)
+	}
+
+	if first := m.First(); !math.IsNaN(first.Key) || first.Value != "other nan" {
+		t.Errorf("expected NaN to be the lowest key. got %v", first)
+	}
+
+	if !m.Has(math.NaN()) || m.Forget(math.NaN()).Has(math.NaN()) || m.Count() != 2 {
+		t.Errorf("expected NaN to be found and forgotten. got %v", m)
+	}
+}
+
+func TestNavigation(t *testing.T) {
+	m := CollectMap(map[int]string{10: "a", 20: "b", 30: "c"})
+
+	testCases := []struct {
+		description string
+		query       func(k int) (Entry[int, string], error)
+		key         int
+		expected    int
+		found       bool
+	}{
+		{"floor of an existing key", m.FloorE, 20, 20, true},
+		{"floor between keys", m.FloorE, 25, 20, true},
+		{"floor below every key", m.FloorE, 5, 0, false},
+		{"lower of an existing key", m.LowerE, 20, 10, true},
+		{"lower of the first key", m.LowerE, 10, 0, false},
+		{"ceiling of an existing key", m.CeilingE, 20, 20, true},
+		{"ceiling between keys", m.CeilingE, 15, 20, true},
+		{"ceiling above every key", m.CeilingE, 35, 0, false},
+		{"higher of an existing key", m.HigherE, 20, 30, true},
+		{"higher of the last key", m.HigherE, 30, 0, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			e, err := tc.query(tc.key)
+
+			if tc.found != (err == nil) {
+				t.Fatalf("unexpected error %v", err)
+			}
+
+			if e.Key != tc.expected {
+				t.Errorf("expected %d. got %d", tc.expected, e.Key)
+			}
+
+			if tc.found && e.Value != m.Get(e.Key) {
+				t.Errorf("unexpected value %s", e.Value)
+			}
+		})
+	}
+}
+
+func TestFirstAndLast(t *testing.T) {
+	m := New[string, int]()
+
+	if _, err := m.FirstE(); err == nil || err.Error() != "value not found: empty collection" {
+		t.Errorf("expected an empty collection error. got %v", err)
+	}
+
+	m.Put("b", 2).Put("a", 1).Put("c", 3)
+
+	if m.First() != (Entry[string, int]{"a", 1}) || m.Last() != (Entry[string, int]{"c", 3}) {
+		t.Errorf("unexpected first and last entries %v, %v", m.First(), m.Last())
+	}
+}
+
+func TestRankAndSelect(t *testing.T) {
+	m := New[int, int]()
+	for i := 0; i < 100; i++ {
+		m.Put(i*2, i)
+	}
+
+	for i := 0; i < 100; i++ {
+		if rank := m.Rank(i * 2); rank != i {
+			t.Fatalf("expected rank of %d to be %d. got %d", i*2, i, rank)
+		}
+
+		if rank := m.Rank(i*2 + 1); rank != i+1 {
+			t.Fatalf("expected rank of %d to be %d. got %d", i*2+1, i+1, rank)
+		}
+
+		if e := m.Select(i); e.Key != i*2 {
+			t.Fatalf("expected entry %d to be %d. got %d", i, i*2, e.Key)
+		}
+	}
+
+	if _, err := m.SelectE(100); err == nil || err.Error() != "value not found: index out of bounds" {
+		t.Errorf("expected an index out of bounds error. got %v", err)
+	}
+}
+
+func TestRange(t *testing.T) {
+	m := New[int, int]()
+	for i := 0; i < 20; i++ {
+		m.Put(i, i*i)
+	}
+
+	r := m.Range(5, 9)
+
+	if expected := slice.Collect(5, 6, 7, 8); !reflect.DeepEqual(r.Keys(), expected) {
+		t.Errorf("expected %v. got %v", expected, r.Keys())
+	}
+
+	if expected := slice.Collect(25, 36, 49, 64); !reflect.DeepEqual(r.ToSliceCollection(), expected) {
+		t.Errorf("expected %v. got %v", expected, r.ToSliceCollection())
+	}
+
+	if empty := m.Range(9, 5); !empty.IsEmpty() {
+		t.Errorf("expected an empty range. got %v", empty.Keys())
+	}
+}
+
+func TestIteration(t *testing.T) {
+	m := FromOrdered(ordered.Collect("c", "a", "b"))
+
+	var asc, desc []int
+	m.Each(func(k int, _ string) { asc = append(asc, k) })
+	m.EachDesc(func(k int, _ string) { desc = append(desc, k) })
+
+	if !reflect.DeepEqual(asc, []int{0, 1, 2}) || !reflect.DeepEqual(desc, []int{2, 1, 0}) {
+		t.Errorf("unexpected iteration order %v, %v", asc, desc)
+	}
+
+	filtered := m.Filter(func(_ int, v string) bool { return v != "a" })
+	if expected := slice.Collect("c", "b"); !reflect.DeepEqual(filtered.Values(), expected) {
+		t.Errorf("expected %v. got %v", expected, filtered.Values())
+	}
+
+	rejected := m.Reject(func(_ int, v string) bool { return v != "a" })
+	if expected := slice.Collect("a"); !reflect.DeepEqual(rejected.Values(), expected) {
+		t.Errorf("expected %v. got %v", expected, rejected.Values())
+	}
+
+	if expected := slice.Collect("c", "a", "b"); !reflect.DeepEqual(m.ToOrdered().ToSliceCollection(), expected) {
+		t.Errorf("expected %v. got %v", expected, m.ToOrdered().ToSliceCollection())
+	}
+}
+
+func TestWhenAndUnless(t *testing.T) {
+	calls := 0
+	f := func(m *SortedMap[int, int]) *SortedMap[int, int] {
+		calls++
+		return m
+	}
+
+	empty := New[int, int]()
+	empty.When(true, f).When(false, f).WhenEmpty(f).WhenNotEmpty(f)
+	empty.Unless(false, f).UnlessEmpty(f).UnlessNotEmpty(f)
+
+	if calls != 4 {
+		t.Errorf("expected f to be called 4 times. got %d", calls)
+	}
+}
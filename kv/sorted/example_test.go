@@ -0,0 +1,27 @@
+package sorted
+
+import "fmt"
+
+func ExampleSortedMap_Floor() {
+	prices := CollectMap(map[int]string{0: "free", 10: "basic", 50: "pro"})
+
+	fmt.Println(prices.Floor(30).Value)
+	fmt.Println(prices.Ceiling(30).Value)
+	// Output:
+	// basic
+	// pro
+}
+
+func ExampleSortedMap_Range() {
+	m := CollectMap(map[int]string{1: "a", 2: "b", 3: "c", 4: "d"})
+
+	fmt.Println(m.Range(2, 4).ToSlice())
+	// Output:
+	// [b c]
+}
+
+func ExampleSortedMap_Format() {
+	fmt.Printf("%v\n", CollectMap(map[int]string{3: "c", 1: "a", 2: "b"}))
+	// Output:
+	// {1:a 2:b 3:c}
+}
@@ -0,0 +1,39 @@
+package sorted
+
+import (
+	"fmt"
+
+	"github.com/thefuga/go-collections/internal"
+)
+
+// String returns the map formatted with %v.
+func (m *SortedMap[K, V]) String() string { return fmt.Sprint(m) }
+
+// Format implements fmt.Formatter, printing the pairs in ascending key order. %v
+// prints the pairs in a single line, %+v prints one pair per line and %#v prints the
+// map in Go syntax. The precision limits the number of pairs printed (e.g. %.10v).
+func (m *SortedMap[K, V]) Format(s fmt.State, verb rune) {
+	internal.Format(s, verb, m, internal.Formatter{
+		Open:  "{",
+		Close: "}",
+		Keyed: true,
+		Each: func(f func(k, v any) bool) {
+			for _, entry := range m.entries() {
+				if !f(entry.Key, entry.Value) {
+					return
+				}
+			}
+		},
+	})
+}
+
+// entries returns the pairs of the map in ascending key order, walking the tree once.
+func (m *SortedMap[K, V]) entries() []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, m.Count())
+
+	m.Each(func(k K, v V) {
+		entries = append(entries, Entry[K, V]{Key: k, Value: v})
+	})
+
+	return entries
+}
@@ -0,0 +1,179 @@
+package sorted
+
+import "github.com/thefuga/go-collections/internal"
+
+// node is a node of a left-leaning red-black tree, augmented with the size of its
+// subtree to support rank and select queries.
+type node[K internal.Relational, V any] struct {
+	key         K
+	value       V
+	left, right *node[K, V]
+	red         bool
+	size        int
+}
+
+func isRed[K internal.Relational, V any](n *node[K, V]) bool {
+	return n != nil && n.red
+}
+
+func size[K internal.Relational, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+
+	return n.size
+}
+
+func put[K internal.Relational, V any](n *node[K, V], k K, v V) (*node[K, V], bool) {
+	if n == nil {
+		return &node[K, V]{key: k, value: v, red: true, size: 1}, true
+	}
+
+	var added bool
+
+	switch c := internal.Compare(k, n.key); {
+	case c < 0:
+		n.left, added = put(n.left, k, v)
+	case c > 0:
+		n.right, added = put(n.right, k, v)
+	default:
+		n.value = v
+	}
+
+	return balance(n), added
+}
+
+func remove[K internal.Relational, V any](n *node[K, V], k K) *node[K, V] {
+	if internal.Compare(k, n.key) < 0 {
+		if !isRed(n.left) && !isRed(n.left.left) {
+			n = moveRedLeft(n)
+		}
+
+		n.left = remove(n.left, k)
+
+		return balance(n)
+	}
+
+	if isRed(n.left) {
+		n = rotateRight(n)
+	}
+
+	if internal.Compare(k, n.key) == 0 && n.right == nil {
+		return nil
+	}
+
+	if !isRed(n.right) && !isRed(n.right.left) {
+		n = moveRedRight(n)
+	}
+
+	if internal.Compare(k, n.key) == 0 {
+		successor := minimum(n.right)
+		n.key, n.value = successor.key, successor.value
+		n.right = removeMin(n.right)
+	} else {
+		n.right = remove(n.right, k)
+	}
+
+	return balance(n)
+}
+
+func removeMin[K internal.Relational, V any](n *node[K, V]) *node[K, V] {
+	if n.left == nil {
+		return nil
+	}
+
+	if !isRed(n.left) && !isRed(n.left.left) {
+		n = moveRedLeft(n)
+	}
+
+	n.left = removeMin(n.left)
+
+	return balance(n)
+}
+
+func minimum[K internal.Relational, V any](n *node[K, V]) *node[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+
+	return n
+}
+
+func maximum[K internal.Relational, V any](n *node[K, V]) *node[K, V] {
+	for n.right != nil {
+		n = n.right
+	}
+
+	return n
+}
+
+func rotateLeft[K internal.Relational, V any](n *node[K, V]) *node[K, V] {
+	x := n.right
+	n.right = x.left
+	x.left = n
+	x.red = n.red
+	n.red = true
+	x.size = n.size
+	n.size = size(n.left) + size(n.right) + 1
+
+	return x
+}
+
+func rotateRight[K internal.Relational, V any](n *node[K, V]) *node[K, V] {
+	x := n.left
+	n.left = x.right
+	x.right = n
+	x.red = n.red
+	n.red = true
+	x.size = n.size
+	n.size = size(n.left) + size(n.right) + 1
+
+	return x
+}
+
+func flipColors[K internal.Relational, V any](n *node[K, V]) {
+	n.red = !n.red
+	n.left.red = !n.left.red
+	n.right.red = !n.right.red
+}
+
+func moveRedLeft[K internal.Relational, V any](n *node[K, V]) *node[K, V] {
+	flipColors(n)
+
+	if isRed(n.right.left) {
+		n.right = rotateRight(n.right)
+		n = rotateLeft(n)
+		flipColors(n)
+	}
+
+	return n
+}
+
+func moveRedRight[K internal.Relational, V any](n *node[K, V]) *node[K, V] {
+	flipColors(n)
+
+	if isRed(n.left.left) {
+		n = rotateRight(n)
+		flipColors(n)
+	}
+
+	return n
+}
+
+func balance[K internal.Relational, V any](n *node[K, V]) *node[K, V] {
+	if isRed(n.right) && !isRed(n.left) {
+		n = rotateLeft(n)
+	}
+
+	if isRed(n.left) && isRed(n.left.left) {
+		n = rotateRight(n)
+	}
+
+	if isRed(n.left) && isRed(n.right) {
+		flipColors(n)
+	}
+
+	n.size = size(n.left) + size(n.right) + 1
+
+	return n
+}
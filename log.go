@@ -0,0 +1,16 @@
+//go:build go1.21
+
+package collections
+
+// LogOptions configures how the collections are logged by their LogValueWith methods.
+type LogOptions struct {
+	// Limit caps the number of logged entries. The omitted entries are counted in an
+	// attribute named "...". A limit lower than 1 logs every entry.
+	Limit int
+	// Redact replaces the values it matches by "[REDACTED]". It is called with the
+	// entries keys and values, as well as with the field names and values of structs.
+	Redact AnyMatcher
+}
+
+// DefaultLogLimit is the Limit used by the LogValue methods, which redact nothing.
+const DefaultLogLimit = 100
@@ -95,17 +95,9 @@ func FieldMatch[V any](field string, matcher AnyMatcher) AnyMatcher {
 			return false
 		}
 
-		fieldVal := reflect.ValueOf(&cast).Elem()
+		fieldVal, ok := internal.FieldByName(reflect.ValueOf(cast), field, "")
 
-		for fieldNum := 0; fieldNum < fieldVal.NumField(); fieldNum++ {
-			if fieldName := fieldVal.Type().Field(fieldNum).Name; fieldName == field {
-				if matcher(0, fieldVal.Field(fieldNum).Interface()) {
-					return true
-				}
-			}
-		}
-
-		return false
+		return ok && matcher(0, fieldVal.Interface())
 	}
 }
 
@@ -189,3 +181,18 @@ func Desc[T internal.Relational]() func(T, T) bool {
 		return current > next
 	}
 }
+
+// Equals can be used as a value comparison strategy, comparing values with ==.
+func Equals[T comparable]() func(T, T) bool {
+	return func(current, other T) bool {
+		return current == other
+	}
+}
+
+// DeepEquals can be used as a value comparison strategy, comparing values with
+// reflect.DeepEqual.
+func DeepEquals[T any]() func(T, T) bool {
+	return func(current, other T) bool {
+		return reflect.DeepEqual(current, other)
+	}
+}
@@ -268,3 +268,23 @@ func TestValueCastGT(t *testing.T) {
 		}
 	}
 }
+
+func TestEquals(t *testing.T) {
+	if !Equals[int]()(1, 1) {
+		t.Error("expected equal values to be equal")
+	}
+
+	if Equals[int]()(1, 2) {
+		t.Error("expected different values to differ")
+	}
+}
+
+func TestDeepEquals(t *testing.T) {
+	if !DeepEquals[[]int]()([]int{1, 2}, []int{1, 2}) {
+		t.Error("expected deeply equal values to be equal")
+	}
+
+	if DeepEquals[[]int]()([]int{1, 2}, []int{2, 1}) {
+		t.Error("expected different values to differ")
+	}
+}
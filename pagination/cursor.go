@@ -0,0 +1,146 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/thefuga/go-collections/errors"
+	"github.com/thefuga/go-collections/internal"
+	"github.com/thefuga/go-collections/kv/ordered"
+	"github.com/thefuga/go-collections/slice"
+)
+
+// CursorPage holds the items following a cursor along with the cursors of the next and
+// previous pages. An empty cursor points to the start of the collection.
+type CursorPage[V any] struct {
+	Items      slice.Collection[V] `json:"items"`
+	Size       int                 `json:"size"`
+	Total      int                 `json:"total"`
+	NextCursor string              `json:"next_cursor,omitempty"`
+	PrevCursor string              `json:"prev_cursor,omitempty"`
+	HasNext    bool                `json:"has_next"`
+	HasPrev    bool                `json:"has_prev"`
+}
+
+// CursorPaginator splits an ordered collection into pages following cursors. The
+// position of every key is indexed once, when the paginator is made, so each page is
+// looked up in constant time. Changes made to the collection afterwards are not seen by
+// the paginator.
+type CursorPaginator[K comparable, V any] struct {
+	keys    []K
+	values  []V
+	indexes map[K]int
+	size    int
+}
+
+// NewCursor makes a CursorPaginator over c. The collection keys act as the cursor field:
+// to paginate by a field of the values (e.g. an id), key the collection by it. Sizes
+// lower than 1 are replaced by 1.
+func NewCursor[K comparable, V any](c ordered.Collection[K, V], size int) CursorPaginator[K, V] {
+	p := CursorPaginator[K, V]{
+		keys:    make([]K, 0, c.Count()),
+		values:  make([]V, 0, c.Count()),
+		indexes: make(map[K]int, c.Count()),
+		size:    internal.Max(size, 1),
+	}
+
+	c.Each(func(k K, v V) {
+		p.indexes[k] = len(p.keys)
+		p.keys = append(p.keys, k)
+		p.values = append(p.values, v)
+	})
+
+	return p
+}
+
+// Cursor returns the page of c following the after cursor, the same way
+// CursorPaginator.PageE does. The collection is indexed on every call: use a
+// CursorPaginator to fetch several pages of the same collection.
+func Cursor[K comparable, V any](c ordered.Collection[K, V], after string, size int) (CursorPage[V], error) {
+	return NewCursor(c, size).PageE(after)
+}
+
+// Size returns the number of items per page.
+func (p CursorPaginator[K, V]) Size() int { return p.size }
+
+// Total returns the number of items being paginated.
+func (p CursorPaginator[K, V]) Total() int { return len(p.keys) }
+
+// Page calls PageE, omitting the error.
+func (p CursorPaginator[K, V]) Page(after string) CursorPage[V] {
+	page, _ := p.PageE(after)
+	return page
+}
+
+// PageE returns up to size items following the one keyed by the after cursor, in the
+// collection order. Cursors are opaque, URL safe strings encoding the keys, as returned
+// by EncodeCursor. An empty cursor returns the first page.
+// Should the cursor be malformed, an instance of errors.InvalidCursorError is
+// returned. Should its key not be in the collection, an instance of
+// errors.KeyNotFoundError is returned.
+func (p CursorPaginator[K, V]) PageE(after string) (CursorPage[V], error) {
+	lower := 0
+
+	if after != "" {
+		k, err := DecodeCursor[K](after)
+		if err != nil {
+			return CursorPage[V]{}, err
+		}
+
+		i, ok := p.indexes[k]
+		if !ok {
+			return CursorPage[V]{}, errors.NewKeyNotFoundError(k)
+		}
+
+		lower = i + 1
+	}
+
+	upper := internal.Min(lower+p.size, len(p.keys))
+
+	page := CursorPage[V]{
+		Items:   make(slice.Collection[V], upper-lower),
+		Size:    p.size,
+		Total:   len(p.keys),
+		HasNext: upper < len(p.keys),
+		HasPrev: lower > 0,
+	}
+
+	copy(page.Items, p.values[lower:upper])
+
+	if page.HasNext {
+		page.NextCursor = EncodeCursor(p.keys[upper-1])
+	}
+
+	if prev := lower - p.size - 1; page.HasPrev && prev >= 0 {
+		page.PrevCursor = EncodeCursor(p.keys[prev])
+	}
+
+	return page, nil
+}
+
+// EncodeCursor encodes k as an opaque cursor, using its JSON representation.
+func EncodeCursor[K any](k K) string {
+	data, err := json.Marshal(k)
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor decodes a cursor encoded by EncodeCursor. Should the cursor be
+// malformed, an instance of errors.InvalidCursorError is returned.
+func DecodeCursor[K any](cursor string) (K, error) {
+	var k K
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return k, errors.NewInvalidCursorError(cursor, err)
+	}
+
+	if err := json.Unmarshal(data, &k); err != nil {
+		return k, errors.NewInvalidCursorError(cursor, err)
+	}
+
+	return k, nil
+}
@@ -0,0 +1,30 @@
+package pagination
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/thefuga/go-collections/kv/ordered"
+)
+
+func ExamplePaginate() {
+	page := Paginate([]string{"a", "b", "c", "d", "e"}, 2, 2)
+
+	data, _ := json.Marshal(page)
+	fmt.Println(string(data))
+	// Output:
+	// {"items":["c","d"],"page":2,"size":2,"total":5,"last_page":3,"has_next":true,"has_prev":true}
+}
+
+func ExampleCursor() {
+	users := ordered.Collect("alice", "bob", "carol")
+
+	first, _ := Cursor(users, "", 2)
+	second, _ := Cursor(users, first.NextCursor, 2)
+
+	fmt.Println(first.Items, first.HasNext)
+	fmt.Println(second.Items, second.HasNext)
+	// Output:
+	// [alice bob] true
+	// [carol] false
+}
@@ -0,0 +1,106 @@
+// Package pagination splits collections into pages, either by page number or by
+// cursor, producing JSON friendly results that can be returned directly by API handlers.
+package pagination
+
+import (
+	"sort"
+
+	"github.com/thefuga/go-collections/errors"
+	"github.com/thefuga/go-collections/internal"
+	"github.com/thefuga/go-collections/slice"
+)
+
+// Page holds the items of a page along with the information needed to navigate the
+// other pages. Pages are numbered from 1.
+type Page[V any] struct {
+	Items    slice.Collection[V] `json:"items"`
+	Page     int                 `json:"page"`
+	Size     int                 `json:"size"`
+	Total    int                 `json:"total"`
+	LastPage int                 `json:"last_page"`
+	HasNext  bool                `json:"has_next"`
+	HasPrev  bool                `json:"has_prev"`
+}
+
+// Paginator splits a collection into pages of a fixed size.
+type Paginator[V any] struct {
+	items slice.Collection[V]
+	size  int
+}
+
+// New makes a Paginator over items. Sizes lower than 1 are replaced by 1.
+func New[V any](items slice.Collection[V], size int) Paginator[V] {
+	return Paginator[V]{items: items, size: internal.Max(size, 1)}
+}
+
+// NewSorted makes a Paginator over a copy of items sorted by less. Equal items keep
+// their relative order, so the pages are the same for every equal input.
+func NewSorted[V any](items slice.Collection[V], size int, less func(current, next V) bool) Paginator[V] {
+	sorted := items.Copy()
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return less(sorted[i], sorted[j])
+	})
+
+	return New(sorted, size)
+}
+
+// Paginate returns the page n of the given items, the same way Paginator.Page does.
+func Paginate[V any](items []V, n, size int) Page[V] {
+	return New(items, size).Page(n)
+}
+
+// Size returns the number of items per page.
+func (p Paginator[V]) Size() int { return p.size }
+
+// Total returns the number of items being paginated.
+func (p Paginator[V]) Total() int { return len(p.items) }
+
+// LastPage returns the number of the last page. Empty collections have a single,
+// empty page.
+func (p Paginator[V]) LastPage() int {
+	return internal.Max((len(p.items)+p.size-1)/p.size, 1)
+}
+
+// Page calls PageE, omitting the error. Pages out of range are returned empty, along
+// with the information of the collection.
+func (p Paginator[V]) Page(n int) Page[V] {
+	page, _ := p.PageE(n)
+	return page
+}
+
+// PageE returns the page n. Should n be lower than 1 or greater than the last page, an
+// empty page and an instance of errors.IndexOutOfBoundsError are returned.
+func (p Paginator[V]) PageE(n int) (Page[V], error) {
+	page := Page[V]{
+		Items:    slice.Collection[V]{},
+		Page:     n,
+		Size:     p.size,
+		Total:    len(p.items),
+		LastPage: p.LastPage(),
+	}
+
+	if n < 1 || n > page.LastPage {
+		return page, errors.NewIndexOutOfBoundsError()
+	}
+
+	lower := (n - 1) * p.size
+	upper := internal.Min(lower+p.size, len(p.items))
+
+	if upper > lower {
+		page.Items = p.items[lower:upper].Copy()
+	}
+	page.HasPrev = n > 1
+	page.HasNext = n < page.LastPage
+
+	return page, nil
+}
+
+// Each calls f with every page, in order.
+func (p Paginator[V]) Each(f func(page Page[V])) Paginator[V] {
+	for n := 1; n <= p.LastPage(); n++ {
+		f(p.Page(n))
+	}
+
+	return p
+}
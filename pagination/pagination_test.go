@@ -0,0 +1,199 @@
+package pagination
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/thefuga/go-collections/kv/ordered"
+	"github.com/thefuga/go-collections/slice"
+)
+
+func TestPage(t *testing.T) {
+	paginator := New(slice.Collect(1, 2, 3, 4, 5), 2)
+
+	testCases := []struct {
+		description string
+		page        int
+		expected    Page[int]
+		err         bool
+	}{
+		{
+			"first page",
+			1,
+			Page[int]{Items: slice.Collect(1, 2), Page: 1, Size: 2, Total: 5, LastPage: 3, HasNext: true},
+			false,
+		},
+		{
+			"middle page",
+			2,
+			Page[int]{Items: slice.Collect(3, 4), Page: 2, Size: 2, Total: 5, LastPage: 3, HasNext: true, HasPrev: true},
+			false,
+		},
+		{
+			"last page",
+			3,
+			Page[int]{Items: slice.Collect(5), Page: 3, Size: 2, Total: 5, LastPage: 3, HasPrev: true},
+			false,
+		},
+		{
+			"page out of range",
+			4,
+			Page[int]{Items: slice.Collection[int]{}, Page: 4, Size: 2, Total: 5, LastPage: 3},
+			true,
+		},
+		{
+			"page lower than 1",
+			0,
+			Page[int]{Items: slice.Collection[int]{}, Page: 0, Size: 2, Total: 5, LastPage: 3},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			page, err := paginator.PageE(tc.page)
+
+			if (err != nil) != tc.err {
+				t.Errorf("expected error to be %v. got %v", tc.err, err)
+			}
+
+			if !reflect.DeepEqual(page, tc.expected) {
+				t.Errorf("expected %+v. got %+v", tc.expected, page)
+			}
+		})
+	}
+}
+
+func TestEmptyCollection(t *testing.T) {
+	page := Paginate[int](nil, 1, 10)
+
+	data, err := json.Marshal(page)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	expected := `{"items":[],"page":1,"size":10,"total":0,"last_page":1,"has_next":false,"has_prev":false}`
+	if string(data) != expected {
+		t.Errorf("expected %s. got %s", expected, data)
+	}
+}
+
+func TestPageItemsDontAlias(t *testing.T) {
+	items := slice.Collect(1, 2, 3, 4)
+	page := New(items, 2).Page(1)
+
+	page.Items[0] = 10
+	page.Items = append(page.Items, 10)
+
+	if expected := slice.Collect(1, 2, 3, 4); !reflect.DeepEqual(items, expected) {
+		t.Errorf("expected %v. got %v", expected, items)
+	}
+}
+
+func TestNewSorted(t *testing.T) {
+	type user struct {
+		Name string
+		Age  int
+	}
+
+	users := slice.Collect(user{"a", 30}, user{"b", 20}, user{"c", 30}, user{"d", 20})
+	paginator := NewSorted(users, 2, func(current, next user) bool { return current.Age < next.Age })
+
+	var pages []slice.Collection[user]
+	paginator.Each(func(page Page[user]) {
+		pages = append(pages, page.Items)
+	})
+
+	expected := []slice.Collection[user]{
+		slice.Collect(user{"b", 20}, user{"d", 20}),
+		slice.Collect(user{"a", 30}, user{"c", 30}),
+	}
+
+	if !reflect.DeepEqual(pages, expected) {
+		t.Errorf("expected %v. got %v", expected, pages)
+	}
+
+	if first := users.First(); first.Name != "a" {
+		t.Errorf("expected the input to be left unsorted. got %v", users)
+	}
+}
+
+func TestCursor(t *testing.T) {
+	c := ordered.CollectMap(map[string]int{})
+	for _, k := range []string{"e", "d", "c", "b", "a"} {
+		c.Put(k, len(c.Keys()))
+	}
+
+	first, err := Cursor(c, "", 2)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(first.Items, slice.Collect(0, 1)) || !first.HasNext || first.HasPrev {
+		t.Errorf("unexpected first page %+v", first)
+	}
+
+	second, err := Cursor(c, first.NextCursor, 2)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(second.Items, slice.Collect(2, 3)) || !second.HasNext || !second.HasPrev || second.PrevCursor != "" {
+		t.Errorf("unexpected second page %+v", second)
+	}
+
+	third, err := Cursor(c, second.NextCursor, 2)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(third.Items, slice.Collect(4)) || third.HasNext || third.NextCursor != "" {
+		t.Errorf("unexpected third page %+v", third)
+	}
+
+	back, err := Cursor(c, third.PrevCursor, 2)
+	if err != nil || !reflect.DeepEqual(back.Items, second.Items) {
+		t.Errorf("expected the previous cursor to point to %v. got %v, %v", second.Items, back.Items, err)
+	}
+}
+
+func TestCursorErrors(t *testing.T) {
+	c := ordered.Collect("a", "b")
+
+	if _, err := Cursor(c, "not a cursor!", 1); err == nil ||
+		err.Error() != "illegal base64 data at input byte 3: invalid cursor 'not a cursor!'" {
+		t.Errorf("expected an invalid cursor error. got %v", err)
+	}
+
+	if _, err := Cursor(c, EncodeCursor(10), 1); err == nil || err.Error() != "key '10' not found" {
+		t.Errorf("expected a key not found error. got %v", err)
+	}
+
+	if _, err := Cursor(c, EncodeCursor("a"), 1); err == nil {
+		t.Error("expected an error decoding a string cursor into an int key")
+	}
+}
+
+func TestCursorPaginator(t *testing.T) {
+	c := ordered.Collect("a", "b", "c")
+	paginator := NewCursor(c, 2)
+	c.Put(3, "d")
+
+	first := paginator.Page("")
+	second := paginator.Page(first.NextCursor)
+
+	if !reflect.DeepEqual(first.Items, slice.Collect("a", "b")) || !reflect.DeepEqual(second.Items, slice.Collect("c")) {
+		t.Errorf("unexpected pages %+v and %+v", first, second)
+	}
+
+	if paginator.Total() != 3 || second.HasNext {
+		t.Errorf("expected the paginator not to see later changes. got %+v", second)
+	}
+
+	first.Items[0] = "z"
+
+	if again := paginator.Page(""); again.Items[0] != "a" {
+		t.Errorf("expected pages not to alias each other. got %v", again.Items)
+	}
+}
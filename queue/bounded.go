@@ -0,0 +1,70 @@
+package queue
+
+import (
+	"sort"
+
+	"github.com/thefuga/go-collections/slice"
+)
+
+// Bounded keeps only the top n values pushed to it, i.e. the n values a PriorityQueue
+// with the same less function would pop first. Pushing runs in O(log n).
+type Bounded[V any] struct {
+	limit int
+	less  func(current, other V) bool
+	heap  *PriorityQueue[V]
+}
+
+// NewBounded makes an empty bounded queue keeping up to limit values ordered by less.
+func NewBounded[V any](limit int, less func(current, other V) bool) *Bounded[V] {
+	// The heap keeps the lowest priority value at its root, so it can be evicted first.
+	reversed := func(current, other V) bool { return less(other, current) }
+
+	return &Bounded[V]{limit: limit, less: less, heap: New(reversed)}
+}
+
+// Count returns the number of values kept.
+func (b *Bounded[V]) Count() int { return b.heap.Count() }
+
+// Limit returns the maximum number of values kept.
+func (b *Bounded[V]) Limit() int { return b.limit }
+
+// Push adds v to the queue, returning whether it was kept. Once the queue is full, v
+// is only kept if it has a higher priority than the lowest priority value, which is
+// then evicted.
+func (b *Bounded[V]) Push(v V) bool {
+	if b.limit <= 0 {
+		return false
+	}
+
+	if b.heap.Count() < b.limit {
+		b.heap.Push(v)
+		return true
+	}
+
+	if !b.less(v, b.heap.Peek()) {
+		return false
+	}
+
+	b.heap.items[0].value = v
+	b.heap.down(0)
+
+	return true
+}
+
+// Threshold calls ThresholdE, omitting the error.
+func (b *Bounded[V]) Threshold() V { return b.heap.Peek() }
+
+// ThresholdE returns the lowest priority value kept, which is the next one to be
+// evicted. Should the queue be empty, an errors.EmptyCollectionError is returned.
+func (b *Bounded[V]) ThresholdE() (V, error) { return b.heap.PeekE() }
+
+// ToSliceCollection returns the values kept, sorted from the highest to the lowest priority.
+func (b *Bounded[V]) ToSliceCollection() slice.Collection[V] {
+	values := b.heap.ToSliceCollection()
+
+	sort.SliceStable(values, func(i, j int) bool {
+		return b.less(values[i], values[j])
+	})
+
+	return values
+}
@@ -0,0 +1,40 @@
+package queue
+
+import (
+	"fmt"
+
+	"github.com/thefuga/go-collections"
+)
+
+func ExamplePriorityQueue() {
+	q := New(collections.Asc[int]())
+	q.Push(3)
+	q.Push(1)
+	item := q.Push(2)
+
+	_ = q.Update(item, 0)
+
+	fmt.Println(q.Pop(), q.Pop(), q.Pop())
+	// Output:
+	// 0 1 3
+}
+
+func ExampleBounded() {
+	top := NewBounded(2, collections.Desc[int]())
+
+	for _, v := range []int{4, 8, 1, 6} {
+		top.Push(v)
+	}
+
+	fmt.Println(top.ToSliceCollection())
+	// Output:
+	// [8 6]
+}
+
+func ExamplePriorityQueue_Format() {
+	q := Heapify([]int{5, 1, 4, 2, 3}, collections.Asc[int]())
+
+	fmt.Printf("%v %.2v\n", q, q)
+	// Output:
+	// [1 2 3 4 5] [1 2 ...]
+}
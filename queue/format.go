@@ -0,0 +1,48 @@
+package queue
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/thefuga/go-collections/internal"
+	"github.com/thefuga/go-collections/slice"
+)
+
+// String returns the queue formatted with %v.
+func (q *PriorityQueue[V]) String() string { return fmt.Sprint(q) }
+
+// Format implements fmt.Formatter, printing the values from the highest to the lowest
+// priority. %v prints the values in a single line, %+v prints one value per line and
+// %#v prints the queue in Go syntax. The precision limits the number of values
+// printed (e.g. %.10v).
+func (q *PriorityQueue[V]) Format(s fmt.State, verb rune) {
+	values := q.ToSliceCollection()
+
+	sort.SliceStable(values, func(i, j int) bool {
+		return q.less(values[i], values[j])
+	})
+
+	format(s, verb, q, values)
+}
+
+// String returns the queue formatted with %v.
+func (b *Bounded[V]) String() string { return fmt.Sprint(b) }
+
+// Format implements fmt.Formatter the same way PriorityQueue does.
+func (b *Bounded[V]) Format(s fmt.State, verb rune) {
+	format(s, verb, b, b.ToSliceCollection())
+}
+
+func format[V any](s fmt.State, verb rune, q any, values slice.Collection[V]) {
+	internal.Format(s, verb, q, internal.Formatter{
+		Open:  "[",
+		Close: "]",
+		Each: func(f func(k, v any) bool) {
+			for i, v := range values {
+				if !f(i, v) {
+					return
+				}
+			}
+		},
+	})
+}
@@ -0,0 +1,213 @@
+// Package queue provides heap based priority queues.
+package queue
+
+import (
+	"github.com/thefuga/go-collections/errors"
+	"github.com/thefuga/go-collections/slice"
+)
+
+// Item is a handle to a value pushed to a PriorityQueue. It can be used to update or
+// remove the value later on.
+type Item[V any] struct {
+	value V
+	index int
+	queue *PriorityQueue[V]
+}
+
+// Value returns the value held by the item.
+func (i *Item[V]) Value() V { return i.value }
+
+// PriorityQueue is a binary heap. The value popped first is the one for which less
+// returns true when compared to every other value, so collections.Asc makes a min-queue
+// and collections.Desc makes a max-queue. Push, Pop, Update and Remove run in O(log n).
+type PriorityQueue[V any] struct {
+	items []*Item[V]
+	less  func(current, other V) bool
+}
+
+// New makes an empty priority queue ordered by less.
+func New[V any](less func(current, other V) bool) *PriorityQueue[V] {
+	return &PriorityQueue[V]{less: less}
+}
+
+// Heapify makes a priority queue holding the values of c in O(n).
+func Heapify[V any](c slice.Collection[V], less func(current, other V) bool) *PriorityQueue[V] {
+	q := &PriorityQueue[V]{items: make([]*Item[V], len(c)), less: less}
+
+	for i, v := range c {
+		q.items[i] = &Item[V]{value: v, index: i, queue: q}
+	}
+
+	for i := len(q.items)/2 - 1; i >= 0; i-- {
+		q.down(i)
+	}
+
+	return q
+}
+
+// Count returns the number of values on the queue.
+func (q *PriorityQueue[V]) Count() int { return len(q.items) }
+
+// IsEmpty checks if the queue is empty.
+func (q *PriorityQueue[V]) IsEmpty() bool { return len(q.items) == 0 }
+
+// Push adds v to the queue, returning its handle.
+func (q *PriorityQueue[V]) Push(v V) *Item[V] {
+	item := &Item[V]{value: v, index: len(q.items), queue: q}
+	q.items = append(q.items, item)
+	q.up(item.index)
+
+	return item
+}
+
+// Pop calls PopE, omitting the error.
+func (q *PriorityQueue[V]) Pop() V {
+	v, _ := q.PopE()
+	return v
+}
+
+// PopE removes and returns the value with the highest priority. Should the queue be
+// empty, a zeroed V and an errors.EmptyCollectionError are returned.
+func (q *PriorityQueue[V]) PopE() (V, error) {
+	if len(q.items) == 0 {
+		return *new(V), errors.NewEmptyCollectionError(errors.NewValueNotFoundError())
+	}
+
+	return q.remove(0), nil
+}
+
+// Peek calls PeekE, omitting the error.
+func (q *PriorityQueue[V]) Peek() V {
+	v, _ := q.PeekE()
+	return v
+}
+
+// PeekE returns the value with the highest priority without removing it.
+func (q *PriorityQueue[V]) PeekE() (V, error) {
+	if len(q.items) == 0 {
+		return *new(V), errors.NewEmptyCollectionError(errors.NewValueNotFoundError())
+	}
+
+	return q.items[0].value, nil
+}
+
+// Update replaces the value of item, restoring the queue order. Should item not be on
+// the queue (e.g. it was already popped), an errors.ValueNotFoundError is returned.
+func (q *PriorityQueue[V]) Update(item *Item[V], v V) error {
+	if !q.holds(item) {
+		return errors.NewValueNotFoundError()
+	}
+
+	item.value = v
+
+	if !q.up(item.index) {
+		q.down(item.index)
+	}
+
+	return nil
+}
+
+// Remove removes item from the queue. Errors are returned the same way as on Update.
+func (q *PriorityQueue[V]) Remove(item *Item[V]) error {
+	if !q.holds(item) {
+		return errors.NewValueNotFoundError()
+	}
+
+	q.remove(item.index)
+
+	return nil
+}
+
+// Merge returns a new queue holding the values of both queues, ordered by the receiver's
+// less function. Neither queue is modified.
+func (q *PriorityQueue[V]) Merge(other *PriorityQueue[V]) *PriorityQueue[V] {
+	values := make(slice.Collection[V], 0, q.Count()+other.Count())
+
+	for _, item := range q.items {
+		values = append(values, item.value)
+	}
+
+	for _, item := range other.items {
+		values = append(values, item.value)
+	}
+
+	return Heapify(values, q.less)
+}
+
+// ToSliceCollection returns the values of the queue in heap order, which is not sorted.
+func (q *PriorityQueue[V]) ToSliceCollection() slice.Collection[V] {
+	values := make(slice.Collection[V], len(q.items))
+
+	for i, item := range q.items {
+		values[i] = item.value
+	}
+
+	return values
+}
+
+func (q *PriorityQueue[V]) holds(item *Item[V]) bool {
+	return item != nil && item.queue == q && item.index >= 0
+}
+
+func (q *PriorityQueue[V]) remove(i int) V {
+	item := q.items[i]
+	last := len(q.items) - 1
+
+	q.swap(i, last)
+	q.items[last] = nil
+	q.items = q.items[:last]
+
+	if i < last && !q.up(i) {
+		q.down(i)
+	}
+
+	item.index = -1
+
+	return item.value
+}
+
+// up moves the item at i towards the root, returning whether it moved.
+func (q *PriorityQueue[V]) up(i int) bool {
+	moved := false
+
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !q.less(q.items[i].value, q.items[parent].value) {
+			break
+		}
+
+		q.swap(i, parent)
+		i = parent
+		moved = true
+	}
+
+	return moved
+}
+
+func (q *PriorityQueue[V]) down(i int) {
+	for {
+		first := i
+		left, right := 2*i+1, 2*i+2
+
+		if left < len(q.items) && q.less(q.items[left].value, q.items[first].value) {
+			first = left
+		}
+
+		if right < len(q.items) && q.less(q.items[right].value, q.items[first].value) {
+			first = right
+		}
+
+		if first == i {
+			return
+		}
+
+		q.swap(i, first)
+		i = first
+	}
+}
+
+func (q *PriorityQueue[V]) swap(i, j int) {
+	q.items[i], q.items[j] = q.items[j], q.items[i]
+	q.items[i].index = i
+	q.items[j].index = j
+}
@@ -0,0 +1,147 @@
+package queue
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/thefuga/go-collections"
+	"github.com/thefuga/go-collections/slice"
+)
+
+func drain[V any](q *PriorityQueue[V]) []V {
+	var values []V
+
+	for !q.IsEmpty() {
+		values = append(values, q.Pop())
+	}
+
+	return values
+}
+
+func TestPushAndPop(t *testing.T) {
+	testCases := []struct {
+		description string
+		less        func(int, int) bool
+		expected    []int
+	}{
+		{"min-queue", collections.Asc[int](), []int{1, 2, 3, 4, 5}},
+		{"max-queue", collections.Desc[int](), []int{5, 4, 3, 2, 1}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			q := New(tc.less)
+			for _, v := range []int{3, 1, 5, 2, 4} {
+				q.Push(v)
+			}
+
+			if q.Peek() != tc.expected[0] {
+				t.Errorf("expected peek to be %d. got %d", tc.expected[0], q.Peek())
+			}
+
+			if popped := drain(q); !reflect.DeepEqual(popped, tc.expected) {
+				t.Errorf("expected %v. got %v", tc.expected, popped)
+			}
+		})
+	}
+}
+
+func TestPopEmpty(t *testing.T) {
+	q := New(collections.Asc[int]())
+
+	if _, err := q.PopE(); err == nil || err.Error() != "value not found: empty collection" {
+		t.Errorf("expected an empty collection error. got %v", err)
+	}
+
+	if _, err := q.PeekE(); err == nil {
+		t.Error("expected an empty collection error")
+	}
+}
+
+func TestHeapify(t *testing.T) {
+	values := rand.Perm(1000)
+	q := Heapify(slice.Collect(values...), collections.Asc[int]())
+
+	sort.Ints(values)
+
+	if popped := drain(q); !reflect.DeepEqual(popped, values) {
+		t.Error("expected values to be popped in ascending order")
+	}
+}
+
+func TestUpdateAndRemove(t *testing.T) {
+	q := New(collections.Asc[int]())
+	items := map[int]*Item[int]{}
+
+	for _, v := range []int{10, 20, 30, 40, 50} {
+		items[v] = q.Push(v)
+	}
+
+	if err := q.Update(items[40], 5); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if err := q.Update(items[10], 45); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if err := q.Remove(items[30]); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if expected := []int{5, 20, 45, 50}; !reflect.DeepEqual(drain(q), expected) {
+		t.Errorf("expected %v to be popped", expected)
+	}
+
+	if err := q.Remove(items[30]); err == nil {
+		t.Error("removing an item twice must return an error")
+	}
+
+	if err := New(collections.Asc[int]()).Update(items[20], 1); err == nil {
+		t.Error("updating an item from another queue must return an error")
+	}
+}
+
+func TestMerge(t *testing.T) {
+	q := Heapify(slice.Collect(5, 1, 3), collections.Asc[int]())
+	other := Heapify(slice.Collect(4, 2), collections.Desc[int]())
+
+	merged := q.Merge(other)
+
+	if expected := []int{1, 2, 3, 4, 5}; !reflect.DeepEqual(drain(merged), expected) {
+		t.Errorf("expected %v to be popped", expected)
+	}
+
+	if q.Count() != 3 || other.Count() != 2 {
+		t.Error("merged queues must be left untouched")
+	}
+}
+
+func TestBounded(t *testing.T) {
+	b := NewBounded(3, collections.Desc[int]())
+	kept := 0
+
+	for _, v := range []int{5, 1, 9, 3, 7, 2, 8} {
+		if b.Push(v) {
+			kept++
+		}
+	}
+
+	if expected := slice.Collect(9, 8, 7); !reflect.DeepEqual(b.ToSliceCollection(), expected) {
+		t.Errorf("expected %v. got %v", expected, b.ToSliceCollection())
+	}
+
+	if kept != 6 {
+		t.Errorf("expected 6 values to be kept at some point. got %d", kept)
+	}
+
+	if b.Threshold() != 7 {
+		t.Errorf("expected threshold to be 7. got %d", b.Threshold())
+	}
+
+	if NewBounded(0, collections.Asc[int]()).Push(1) {
+		t.Error("a queue limited to 0 values must not keep any")
+	}
+}
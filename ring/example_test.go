@@ -0,0 +1,33 @@
+package ring
+
+import "fmt"
+
+func ExampleRing() {
+	r := New[string](2, Overwrite)
+	r.Push("a").Push("b").Push("c")
+
+	fmt.Println(r.ToSlice(), r.Oldest(), r.Newest())
+	// Output:
+	// [b c] b c
+}
+
+func ExampleNumeric() {
+	latencies := NewNumeric[int](3, Overwrite)
+
+	for _, v := range []int{50, 10, 20, 30} {
+		latencies.Push(v)
+	}
+
+	fmt.Println(latencies.Average(), latencies.Median())
+	// Output:
+	// 20 20
+}
+
+func ExampleRing_Format() {
+	r := New[int](3, Overwrite)
+	r.Push(1).Push(2).Push(3).Push(4)
+
+	fmt.Printf("%v\n", r)
+	// Output:
+	// [2 3 4]
+}
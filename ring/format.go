@@ -0,0 +1,25 @@
+package ring
+
+import (
+	"fmt"
+
+	"github.com/thefuga/go-collections/internal"
+)
+
+// String returns the ring formatted with %v.
+func (r *Ring[V]) String() string { return fmt.Sprint(r) }
+
+// Format implements fmt.Formatter, printing the elements from the oldest to the
+// newest. %v prints the elements in a single line, %+v prints one element per line
+// and %#v prints the ring in Go syntax. The precision limits the number of elements
+// printed (e.g. %.10v).
+func (r *Ring[V]) Format(s fmt.State, verb rune) {
+	internal.Format(s, verb, r, internal.Formatter{
+		Open:  "[",
+		Close: "]",
+		Each: func(f func(k, v any) bool) {
+			for i := 0; i < r.count && f(i, r.buffer[r.index(i)]); i++ {
+			}
+		},
+	})
+}
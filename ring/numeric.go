@@ -0,0 +1,42 @@
+package ring
+
+import (
+	"github.com/thefuga/go-collections"
+	"github.com/thefuga/go-collections/internal"
+)
+
+// Numeric is a ring holding numbers, which allows for statistics over the window of
+// elements currently on the ring.
+type Numeric[V internal.Number] struct {
+	*Ring[V]
+}
+
+// NewNumeric makes an empty numeric ring. See New.
+func NewNumeric[V internal.Number](capacity int, mode Mode) Numeric[V] {
+	return Numeric[V]{Ring: New[V](capacity, mode)}
+}
+
+// Sum sums all values on the ring.
+func (n Numeric[V]) Sum() V { return collections.Sum(n.ToSlice()) }
+
+// Average calculates the average value on the ring. Should the ring be empty, 0 is returned.
+func (n Numeric[V]) Average() V { return collections.Average(n.ToSlice()) }
+
+// AverageE calculates the average value on the ring. Should the ring be empty, an
+// errors.EmptyCollectionError is returned.
+func (n Numeric[V]) AverageE() (V, error) { return collections.AverageE(n.ToSlice()) }
+
+// Median calculates the median value on the ring. Should the ring be empty, 0 is returned.
+func (n Numeric[V]) Median() float64 {
+	if n.IsEmpty() {
+		return 0
+	}
+
+	return collections.Median(n.ToSlice())
+}
+
+// Min returns the minimal value on the ring. Should the ring be empty, 0 is returned.
+func (n Numeric[V]) Min() V { return collections.Min(n.ToSlice()) }
+
+// Max returns the maximum value on the ring. Should the ring be empty, 0 is returned.
+func (n Numeric[V]) Max() V { return collections.Max(n.ToSlice()) }
@@ -0,0 +1,167 @@
+// Package ring provides a fixed capacity ring buffer, useful for sliding windows such
+// as recent events or metrics.
+package ring
+
+import (
+	"github.com/thefuga/go-collections"
+	"github.com/thefuga/go-collections/errors"
+	"github.com/thefuga/go-collections/slice"
+)
+
+// Mode defines what happens when pushing to a full ring.
+type Mode int
+
+const (
+	// Overwrite replaces the oldest element with the pushed one.
+	Overwrite Mode = iota
+	// Reject refuses the pushed element, returning an errors.FullCollectionError.
+	Reject
+)
+
+// Ring is a fixed capacity buffer holding the most recent elements pushed to it. Make
+// it with New or Collect: the zero value has no capacity, so every push is rejected.
+type Ring[V any] struct {
+	buffer []V
+	head   int
+	count  int
+	mode   Mode
+}
+
+// New makes an empty ring holding up to capacity elements. Should capacity be lower
+// than 1, the ring holds a single element.
+func New[V any](capacity int, mode Mode) *Ring[V] {
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	return &Ring[V]{buffer: make([]V, capacity), mode: mode}
+}
+
+// Collect makes a new overwriting ring with the capacity of the given values, holding them.
+func Collect[V any](values ...V) *Ring[V] {
+	r := New[V](len(values), Overwrite)
+
+	for _, v := range values {
+		r.Push(v)
+	}
+
+	return r
+}
+
+// Count returns the number of elements on the ring.
+func (r *Ring[V]) Count() int { return r.count }
+
+// Capacity returns the maximum number of elements on the ring.
+func (r *Ring[V]) Capacity() int { return len(r.buffer) }
+
+// IsEmpty checks if the ring is empty.
+func (r *Ring[V]) IsEmpty() bool { return r.count == 0 }
+
+// IsFull checks if the ring holds as many elements as its capacity.
+func (r *Ring[V]) IsFull() bool { return r.count == len(r.buffer) }
+
+// Push calls PushE, omitting the error.
+func (r *Ring[V]) Push(v V) *Ring[V] {
+	_ = r.PushE(v)
+	return r
+}
+
+// PushE adds v as the newest element of the ring. Should the ring be full, the oldest
+// element is overwritten when on Overwrite mode. On Reject mode, v is discarded and an
+// errors.FullCollectionError is returned. Rings without capacity reject every push.
+func (r *Ring[V]) PushE(v V) error {
+	if r.IsFull() {
+		if r.mode == Reject || len(r.buffer) == 0 {
+			return errors.NewFullCollectionError()
+		}
+
+		r.buffer[r.head] = v
+		r.head = r.index(1)
+
+		return nil
+	}
+
+	r.buffer[r.index(r.count)] = v
+	r.count++
+
+	return nil
+}
+
+// Get calls GetE, omitting the error.
+func (r *Ring[V]) Get(i int) V {
+	v, _ := r.GetE(i)
+	return v
+}
+
+// GetE returns the element at the index i, where 0 is the oldest element. Errors are
+// returned the same way as on collections.GetE.
+func (r *Ring[V]) GetE(i int) (V, error) {
+	if r.count == 0 {
+		return *new(V), errors.NewEmptyCollectionError(errors.NewValueNotFoundError())
+	}
+
+	if i < 0 || i >= r.count {
+		return *new(V), errors.NewIndexOutOfBoundsError(errors.NewValueNotFoundError())
+	}
+
+	return r.buffer[r.index(i)], nil
+}
+
+// Oldest calls OldestE, omitting the error.
+func (r *Ring[V]) Oldest() V { return r.Get(0) }
+
+// OldestE returns the oldest element of the ring.
+func (r *Ring[V]) OldestE() (V, error) { return r.GetE(0) }
+
+// Newest calls NewestE, omitting the error.
+func (r *Ring[V]) Newest() V { return r.Get(r.count - 1) }
+
+// NewestE returns the newest element of the ring.
+func (r *Ring[V]) NewestE() (V, error) { return r.GetE(r.count - 1) }
+
+// Each calls f with every element of the ring, from the oldest to the newest.
+func (r *Ring[V]) Each(f func(i int, v V)) *Ring[V] {
+	for i := 0; i < r.count; i++ {
+		f(i, r.buffer[r.index(i)])
+	}
+
+	return r
+}
+
+// Contains checks if the ring holds at least one value matching the given matcher.
+func (r *Ring[V]) Contains(matcher collections.Matcher[int, V]) bool {
+	for i := 0; i < r.count; i++ {
+		if matcher(i, r.buffer[r.index(i)]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Clear removes every element of the ring, keeping its capacity.
+func (r *Ring[V]) Clear() *Ring[V] {
+	r.buffer = make([]V, len(r.buffer))
+	r.head, r.count = 0, 0
+
+	return r
+}
+
+// ToSlice makes a new slice holding the elements of the ring, from the oldest to the newest.
+func (r *Ring[V]) ToSlice() []V {
+	result := make([]V, 0, r.count)
+
+	r.Each(func(_ int, v V) {
+		result = append(result, v)
+	})
+
+	return result
+}
+
+// ToSliceCollection returns a snapshot of the ring as a slice.Collection. Later pushes
+// don't affect the returned collection.
+func (r *Ring[V]) ToSliceCollection() slice.Collection[V] { return r.ToSlice() }
+
+func (r *Ring[V]) index(i int) int {
+	return (r.head + i) % len(r.buffer)
+}
@@ -0,0 +1,135 @@
+package ring
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thefuga/go-collections"
+	"github.com/thefuga/go-collections/slice"
+)
+
+func TestPush(t *testing.T) {
+	testCases := []struct {
+		description string
+		mode        Mode
+		pushed      []int
+		expected    []int
+		rejected    int
+	}{
+		{"not full", Overwrite, []int{1, 2}, []int{1, 2}, 0},
+		{"overwrite", Overwrite, []int{1, 2, 3, 4, 5}, []int{3, 4, 5}, 0},
+		{"overwrite many laps", Overwrite, []int{1, 2, 3, 4, 5, 6, 7, 8}, []int{6, 7, 8}, 0},
+		{"reject", Reject, []int{1, 2, 3, 4, 5}, []int{1, 2, 3}, 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			r := New[int](3, tc.mode)
+			rejected := 0
+
+			for _, v := range tc.pushed {
+				if err := r.PushE(v); err != nil {
+					if err.Error() != "full collection" {
+						t.Errorf("unexpected error %v", err)
+					}
+					rejected++
+				}
+			}
+
+			if !reflect.DeepEqual(r.ToSlice(), tc.expected) {
+				t.Errorf("expected %v. got %v", tc.expected, r.ToSlice())
+			}
+
+			if rejected != tc.rejected {
+				t.Errorf("expected %d rejections. got %d", tc.rejected, rejected)
+			}
+
+			if r.Oldest() != tc.expected[0] || r.Newest() != tc.expected[len(tc.expected)-1] {
+				t.Errorf("unexpected oldest and newest: %v, %v", r.Oldest(), r.Newest())
+			}
+		})
+	}
+}
+
+func TestEmptyRing(t *testing.T) {
+	r := New[int](0, Overwrite)
+
+	if r.Capacity() != 1 {
+		t.Errorf("expected capacity to be 1. got %d", r.Capacity())
+	}
+
+	if _, err := r.OldestE(); err == nil || err.Error() != "value not found: empty collection" {
+		t.Errorf("expected an empty collection error. got %v", err)
+	}
+
+	if _, err := r.Push(1).Push(2).GetE(1); err == nil || err.Error() != "value not found: index out of bounds" {
+		t.Errorf("expected an index out of bounds error. got %v", err)
+	}
+}
+
+func TestZeroValue(t *testing.T) {
+	var r Ring[int]
+
+	if err := r.PushE(1); err == nil || err.Error() != "full collection" {
+		t.Errorf("expected a full collection error. got %v", err)
+	}
+
+	if !r.IsEmpty() || r.Contains(collections.ValueEquals[int](0)) {
+		t.Errorf("expected the ring to be left empty. got %v", r.ToSlice())
+	}
+}
+
+func TestContains(t *testing.T) {
+	r := New[int](3, Overwrite).Push(1).Push(2).Push(3).Push(4)
+
+	var visited []int
+	found := r.Contains(func(_ int, v int) bool {
+		visited = append(visited, v)
+		return v == 3
+	})
+
+	if !found || !reflect.DeepEqual(visited, []int{2, 3}) {
+		t.Errorf("expected to stop at 3 after visiting [2 3]. got %v after %v", found, visited)
+	}
+}
+
+func TestSnapshotAndClear(t *testing.T) {
+	r := Collect(1, 2, 3)
+	snapshot := r.ToSliceCollection()
+
+	r.Push(4)
+
+	if expected := slice.Collect(1, 2, 3); !reflect.DeepEqual(snapshot, expected) {
+		t.Errorf("expected snapshot to be %v. got %v", expected, snapshot)
+	}
+
+	if !r.IsFull() || !r.Contains(collections.ValueEquals[int](4)) {
+		t.Error("expected the ring to be full and to contain 4")
+	}
+
+	r.Clear()
+
+	if !r.IsEmpty() || r.Capacity() != 3 {
+		t.Error("expected the ring to be empty and to keep its capacity")
+	}
+}
+
+func TestNumeric(t *testing.T) {
+	r := NewNumeric[float64](4, Overwrite)
+
+	if r.Median() != 0 || r.Average() != 0 {
+		t.Error("expected statistics of an empty ring to be 0")
+	}
+
+	for _, v := range []float64{100, 1, 7, 3, 5} {
+		r.Push(v)
+	}
+
+	if r.Sum() != 16 || r.Average() != 4 || r.Median() != 4 || r.Min() != 1 || r.Max() != 7 {
+		t.Errorf("unexpected statistics for %v", r.ToSlice())
+	}
+
+	if expected := []float64{1, 7, 3, 5}; !reflect.DeepEqual(r.ToSlice(), expected) {
+		t.Errorf("computing the median must not change the ring order. got %v", r.ToSlice())
+	}
+}
@@ -0,0 +1,29 @@
+package slice
+
+import "github.com/thefuga/go-collections/internal"
+
+// MarshalBinary implements encoding.BinaryMarshaler. The encoding is versioned and
+// holds the values in order.
+func (c Collection[V]) MarshalBinary() ([]byte, error) {
+	return internal.EncodeBinary([]V(c))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, decoding data encoded by
+// MarshalBinary into the collection.
+func (c *Collection[V]) UnmarshalBinary(data []byte) error {
+	var values []V
+
+	if err := internal.DecodeBinary(data, &values); err != nil {
+		return err
+	}
+
+	*c = values
+
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder by delegating to MarshalBinary.
+func (c Collection[V]) GobEncode() ([]byte, error) { return c.MarshalBinary() }
+
+// GobDecode implements gob.GobDecoder by delegating to UnmarshalBinary.
+func (c *Collection[V]) GobDecode(data []byte) error { return c.UnmarshalBinary(data) }
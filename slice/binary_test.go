@@ -0,0 +1,79 @@
+package slice
+
+import (
+	"bytes"
+	"encoding/gob"
+	"reflect"
+	"testing"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	testCases := []struct {
+		description string
+		collection  Collection[string]
+	}{
+		{"empty collection", Collection[string]{}},
+		{"collection with values", Collect("c", "a", "b")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			data, err := tc.collection.MarshalBinary()
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+
+			var decoded Collection[string]
+			if err := decoded.UnmarshalBinary(data); err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+
+			if decoded.Count() != tc.collection.Count() ||
+				(tc.collection.Count() > 0 && !reflect.DeepEqual(decoded, tc.collection)) {
+				t.Errorf("expected %v. got %v", tc.collection, decoded)
+			}
+		})
+	}
+}
+
+func TestGob(t *testing.T) {
+	type payload struct {
+		Values Collection[int]
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(payload{Collect(3, 1, 2)}); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	var decoded payload
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if expected := Collect(3, 1, 2); !reflect.DeepEqual(decoded.Values, expected) {
+		t.Errorf("expected %v. got %v", expected, decoded.Values)
+	}
+}
+
+func TestUnmarshalBinaryErrors(t *testing.T) {
+	testCases := []struct {
+		description string
+		data        []byte
+		expected    string
+	}{
+		{"empty data", nil, "invalid encoding"},
+		{"unknown version", []byte{2}, "unsupported encoding version '2'"},
+		{"malformed data", []byte{1, 42}, "unexpected EOF: invalid encoding"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			var c Collection[int]
+
+			if err := c.UnmarshalBinary(tc.data); err == nil || err.Error() != tc.expected {
+				t.Errorf("expected %v. got %v", tc.expected, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package slice
+
+import "context"
+
+// FromChan collects every value received from ch until it is closed.
+func FromChan[V any](ch <-chan V) Collection[V] {
+	var c Collection[V]
+
+	for v := range ch {
+		c = append(c, v)
+	}
+
+	return c
+}
+
+// CollectChan collects the values received from ch until it is closed or ctx is done.
+// Should ctx be done first, the values collected so far are returned along with
+// ctx.Err().
+func CollectChan[V any](ctx context.Context, ch <-chan V) (Collection[V], error) {
+	var c Collection[V]
+
+	for {
+		select {
+		case <-ctx.Done():
+			return c, ctx.Err()
+		case v, ok := <-ch:
+			if !ok {
+				return c, nil
+			}
+
+			c = append(c, v)
+		}
+	}
+}
+
+// ToChan returns a closed channel buffered with the values of the collection, in order.
+func (c Collection[V]) ToChan() <-chan V {
+	ch := make(chan V, len(c))
+
+	for _, v := range c {
+		ch <- v
+	}
+
+	close(ch)
+
+	return ch
+}
@@ -0,0 +1,47 @@
+package slice
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestChanRoundTrip(t *testing.T) {
+	c := Collect(1, 2, 3)
+
+	if actual := FromChan(c.ToChan()); !reflect.DeepEqual(actual, c) {
+		t.Errorf("expected %v. got %v", c, actual)
+	}
+}
+
+func TestCollectChan(t *testing.T) {
+	actual, err := CollectChan(context.Background(), Collect("a", "b").ToChan())
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if expected := Collect("a", "b"); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v. got %v", expected, actual)
+	}
+}
+
+func TestCollectChanCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan int)
+
+	go func() {
+		ch <- 1
+		ch <- 2
+		cancel()
+	}()
+
+	actual, err := CollectChan(ctx, ch)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected %v. got %v", context.Canceled, err)
+	}
+
+	if expected := Collect(1, 2); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v. got %v", expected, actual)
+	}
+}
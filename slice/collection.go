@@ -82,6 +82,18 @@ func (c Collection[V]) Each(f func(i int, v V)) Collection[V] {
 	return c
 }
 
+// EachWhile passes the collection and the given params to the generic EachWhile function
+// and returns the collection.
+func (c Collection[V]) EachWhile(f func(i int, v V) bool) Collection[V] {
+	collections.EachWhile(f, c)
+	return c
+}
+
+// EachE passes the collection and the given params to the generic EachE function.
+func (c Collection[V]) EachE(f func(i int, v V) error) error {
+	return collections.EachE(f, c)
+}
+
 // Reverse reverses the collection
 func (c Collection[V]) Reverse() Collection[V] {
 	return collections.Reverse(c)
@@ -701,3 +701,31 @@ func TestForgetE(t *testing.T) {
 		})
 	}
 }
+
+func TestEachWhileMethod(t *testing.T) {
+	var visited Collection[int]
+
+	Collect(1, 2, 3).EachWhile(func(_ int, v int) bool {
+		visited = visited.Push(v)
+		return v < 2
+	})
+
+	if expected := Collect(1, 2); !reflect.DeepEqual(visited, expected) {
+		t.Errorf("expected %v. got %v", expected, visited)
+	}
+}
+
+func TestEachEMethod(t *testing.T) {
+	failure := fmt.Errorf("failure")
+
+	err := Collect(1, 2, 3).EachE(func(_ int, v int) error {
+		if v == 2 {
+			return failure
+		}
+		return nil
+	})
+
+	if err != failure {
+		t.Errorf("expected %v. got %v", failure, err)
+	}
+}
@@ -0,0 +1,28 @@
+package slice
+
+import (
+	"context"
+
+	"github.com/thefuga/go-collections"
+)
+
+// EachCtx passes the collection and the given params to the generic EachCtx function.
+func (c Collection[V]) EachCtx(ctx context.Context, f func(i int, v V) error) error {
+	return collections.EachCtx(ctx, f, c)
+}
+
+// MapCtx passes the collection and the given params to the generic MapCtx function.
+func (c Collection[V]) MapCtx(ctx context.Context, f func(i int, v V) (V, error)) (Collection[V], error) {
+	return collections.MapCtx(ctx, c, f)
+}
+
+// FilterCtx passes the collection and the given params to the generic FilterCtx function.
+func (c Collection[V]) FilterCtx(ctx context.Context, f func(i int, v V) (bool, error)) (Collection[V], error) {
+	return collections.FilterCtx(ctx, c, f)
+}
+
+// ReduceCtx passes the collection and the given params to the generic ReduceCtx
+// function. The carry has the same type as the collection values.
+func (c Collection[V]) ReduceCtx(ctx context.Context, f func(carry V, v V, i int) (V, error), carry V) (V, error) {
+	return collections.ReduceCtx(ctx, c, f, carry)
+}
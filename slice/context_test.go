@@ -0,0 +1,44 @@
+package slice
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCtxMethods(t *testing.T) {
+	ctx := context.Background()
+	c := Collect(1, 2, 3)
+
+	doubled, err := c.MapCtx(ctx, func(_ int, v int) (int, error) { return v * 2, nil })
+	if err != nil || !reflect.DeepEqual(doubled, Collect(2, 4, 6)) {
+		t.Errorf("expected [2 4 6]. got %v, %v", doubled, err)
+	}
+
+	odd, err := c.FilterCtx(ctx, func(_ int, v int) (bool, error) { return v%2 == 1, nil })
+	if err != nil || !reflect.DeepEqual(odd, Collect(1, 3)) {
+		t.Errorf("expected [1 3]. got %v, %v", odd, err)
+	}
+
+	sum, err := c.ReduceCtx(ctx, func(carry, v, _ int) (int, error) { return carry + v, nil }, 0)
+	if err != nil || sum != 6 {
+		t.Errorf("expected 6. got %v, %v", sum, err)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	if err := c.EachCtx(canceled, func(int, int) error { return nil }); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected %v. got %v", context.Canceled, err)
+	}
+	if mapped, err := c.MapCtx(canceled, func(_ int, v int) (int, error) { return v, nil }); mapped == nil ||
+		!mapped.IsEmpty() || !errors.Is(err, context.Canceled) {
+		t.Errorf("expected an empty collection and %v. got %v, %v", context.Canceled, mapped, err)
+	}
+
+	if filtered, err := c.FilterCtx(canceled, func(int, int) (bool, error) { return true, nil }); filtered == nil ||
+		!filtered.IsEmpty() || !errors.Is(err, context.Canceled) {
+		t.Errorf("expected an empty collection and %v. got %v, %v", context.Canceled, filtered, err)
+	}
+}
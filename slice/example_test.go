@@ -131,3 +131,28 @@ func ExampleCollection_Count() {
 	// Output:
 	// 4
 }
+
+func ExampleCollection_Format() {
+	c := Collect(1, 2, 3, 4)
+	fmt.Printf("%.2v\n", c)
+	fmt.Printf("%+v", c[:2])
+	// Output:
+	// [1 2 ...]
+	// [
+	//   1
+	//   2
+	// ]
+}
+
+func ExampleCollection_Table() {
+	type user struct {
+		ID   int    `table:"id"`
+		Name string `table:"name"`
+	}
+
+	fmt.Print(Collect(user{1, "Alice"}, user{2, "Bob"}).Table())
+	// Output:
+	// id  name
+	// 1   Alice
+	// 2   Bob
+}
@@ -0,0 +1,33 @@
+package slice
+
+import (
+	"fmt"
+
+	"github.com/thefuga/go-collections/internal"
+)
+
+// String returns the collection formatted with %v.
+func (c Collection[V]) String() string { return fmt.Sprint(c) }
+
+// Format implements fmt.Formatter. %v prints the values in a single line, %+v prints
+// one value per line and %#v prints the collection in Go syntax. The precision limits
+// the number of values printed (e.g. %.10v). Other verbs print the collection the
+// same way they print a plain slice (e.g. %x on a Collection[byte]).
+func (c Collection[V]) Format(s fmt.State, verb rune) {
+	if verb != 'v' {
+		fmt.Fprintf(s, internal.FormatString(s, verb), []V(c))
+		return
+	}
+
+	internal.Format(s, verb, c, internal.Formatter{
+		Open:  "[",
+		Close: "]",
+		Each: func(f func(k, v any) bool) {
+			for i, v := range c {
+				if !f(i, v) {
+					return
+				}
+			}
+		},
+	})
+}
@@ -0,0 +1,77 @@
+package slice
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	testCases := []struct {
+		description string
+		format      string
+		collection  any
+		expected    string
+	}{
+		{"single line", "%v", Collect(1, 2, 3), "[1 2 3]"},
+		{"empty", "%v", Collection[int]{}, "[]"},
+		{"truncated", "%.2v", Collect(1, 2, 3), "[1 2 ...]"},
+		{"precision above count", "%.5v", Collect(1, 2, 3), "[1 2 3]"},
+		{"multi line", "%+v", Collect(1, 2), "[\n  1\n  2\n]"},
+		{"empty multi line", "%+v", Collection[int]{}, "[]"},
+		{"nested multi line", "%+v", Collect(Collect(1)), "[\n  [\n    1\n  ]\n]"},
+		{"go syntax", "%#v", Collect("a", "b"), `slice.Collection[string]{"a", "b"}`},
+		{"element verb", "%q", Collect("a", "b"), `["a" "b"]`},
+		{"element width", "%3d", Collect(1, 2), "[  1   2]"},
+		{"bytes hex", "%x", Collect[byte](1, 171), "01ab"},
+		{"bytes string", "%s", Collect[byte]('h', 'i'), "hi"},
+		{"quoted strings", "%q", Collect("a", "b c"), `["a" "b c"]`},
+		{"string", "%s", Collect("a", "b").String(), "[a b]"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if actual := fmt.Sprintf(tc.format, tc.collection); actual != tc.expected {
+				t.Errorf("expected %q. got %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestTable(t *testing.T) {
+	type row struct {
+		Name   string
+		Amount int `table:"amount"`
+		secret string
+		Hidden bool `table:"-"`
+	}
+
+	testCases := []struct {
+		description string
+		table       string
+		expected    string
+	}{
+		{
+			"structs",
+			Collect(row{"foo", 10, "x", true}, row{"barbaz", 2, "y", false}).Table(),
+			"Name    amount\nfoo     10\nbarbaz  2\n",
+		},
+		{
+			"pointers to structs",
+			Collect(&row{Name: "foo"}, nil).Table(),
+			"Name  amount\nfoo   0\n      \n",
+		},
+		{
+			"non struct values",
+			Collect(1, 22).Table(),
+			"Value\n1\n22\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if tc.table != tc.expected {
+				t.Errorf("expected %q. got %q", tc.expected, tc.table)
+			}
+		})
+	}
+}
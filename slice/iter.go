@@ -0,0 +1,50 @@
+//go:build go1.23
+
+package slice
+
+import "iter"
+
+// CollectSeq collects the values yielded by seq into a new Collection.
+func CollectSeq[V any](seq iter.Seq[V]) Collection[V] {
+	var c Collection[V]
+
+	for v := range seq {
+		c = append(c, v)
+	}
+
+	return c
+}
+
+// All returns an iterator over the indexes and values of the collection, in order.
+func (c Collection[V]) All() iter.Seq2[int, V] {
+	return func(yield func(int, V) bool) {
+		for i, v := range c {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the indexes and values of the collection, from
+// the last to the first.
+func (c Collection[V]) Backward() iter.Seq2[int, V] {
+	return func(yield func(int, V) bool) {
+		for i := len(c) - 1; i >= 0; i-- {
+			if !yield(i, c[i]) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over the values of the collection, in order.
+func (c Collection[V]) ValuesSeq() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range c {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,44 @@
+//go:build go1.23
+
+package slice
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAll(t *testing.T) {
+	var indexes, values []int
+
+	for i, v := range Collect(10, 20, 30, 40).All() {
+		if v == 30 {
+			break
+		}
+
+		indexes, values = append(indexes, i), append(values, v)
+	}
+
+	if !reflect.DeepEqual(indexes, []int{0, 1}) || !reflect.DeepEqual(values, []int{10, 20}) {
+		t.Errorf("expected [0 1] [10 20]. got %v %v", indexes, values)
+	}
+}
+
+func TestBackward(t *testing.T) {
+	var indexes []int
+
+	for i := range Collect("a", "b", "c").Backward() {
+		indexes = append(indexes, i)
+	}
+
+	if expected := []int{2, 1, 0}; !reflect.DeepEqual(indexes, expected) {
+		t.Errorf("expected %v. got %v", expected, indexes)
+	}
+}
+
+func TestCollectSeq(t *testing.T) {
+	c := Collect("a", "b", "c")
+
+	if actual := CollectSeq(c.ValuesSeq()); !reflect.DeepEqual(actual, c) {
+		t.Errorf("expected %v. got %v", c, actual)
+	}
+}
@@ -0,0 +1,29 @@
+//go:build go1.21
+
+package slice
+
+import (
+	"log/slog"
+
+	"github.com/thefuga/go-collections"
+	"github.com/thefuga/go-collections/internal"
+)
+
+// LogValue implements slog.LogValuer, logging the collection as a group keyed by the
+// values indexes. Up to collections.DefaultLogLimit values are logged and nothing is
+// redacted. See LogValueWith.
+func (c Collection[V]) LogValue() slog.Value {
+	return c.LogValueWith(collections.LogOptions{Limit: collections.DefaultLogLimit})
+}
+
+// LogValueWith logs the collection the same way LogValue does, using the given options
+// for limits and redaction.
+func (c Collection[V]) LogValueWith(options collections.LogOptions) slog.Value {
+	return internal.LogValue(len(c), options.Limit, options.Redact, func(f func(k, v any) bool) {
+		for i, v := range c {
+			if !f(i, v) {
+				return
+			}
+		}
+	})
+}
@@ -0,0 +1,94 @@
+//go:build go1.21
+
+package slice
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/thefuga/go-collections"
+)
+
+func logLine(v slog.Value) string {
+	var buf bytes.Buffer
+
+	slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey || a.Key == slog.MessageKey) {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})).Info("", "c", v)
+
+	return buf.String()
+}
+
+func TestLogValue(t *testing.T) {
+	type user struct {
+		Name     string
+		Password string `log:"password"`
+		internal int
+	}
+
+	secret := func(k, _ any) bool { return k == "password" }
+
+	testCases := []struct {
+		description string
+		value       slog.Value
+		expected    string
+	}{
+		{
+			"all values",
+			Collect("a", "b").LogValueWith(collections.LogOptions{}),
+			"c.0=a c.1=b\n",
+		},
+		{
+			"limited values",
+			Collect(1, 2, 3).LogValueWith(collections.LogOptions{Limit: 2}),
+			"c.0=1 c.1=2 c....=1\n",
+		},
+		{
+			"redacted values",
+			Collect(1, 2).LogValueWith(collections.LogOptions{Redact: collections.KeyEquals(1)}),
+			"c.0=1 c.1=[REDACTED]\n",
+		},
+		{
+			"redacted struct fields",
+			Collect(user{"foo", "bar", 1}, user{"baz", "qux", 2}).LogValueWith(collections.LogOptions{Redact: secret}),
+			"c.0.Name=foo c.0.password=[REDACTED] c.1.Name=baz c.1.password=[REDACTED]\n",
+		},
+		{
+			"pointers to structs",
+			Collect(&user{Name: "foo"}, nil).LogValueWith(collections.LogOptions{}),
+			"c.0.Name=foo c.0.password=\"\" c.1=<nil>\n",
+		},
+		{
+			"nested collections",
+			Collect(Collect(1, 2), Collect(3)).LogValueWith(collections.LogOptions{}),
+			"c.0.0=1 c.0.1=2 c.1.0=3\n",
+		},
+		{
+			"default options",
+			Collect(1).LogValue(),
+			"c.0=1\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if actual := logLine(tc.value); actual != tc.expected {
+				t.Errorf("expected %q. got %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLogValueDefaultLimit(t *testing.T) {
+	attrs := Collection[int](make([]int, collections.DefaultLogLimit+2)).LogValue().Group()
+
+	if len(attrs) != collections.DefaultLogLimit+1 || !attrs[len(attrs)-1].Equal(slog.Int("...", 2)) {
+		t.Errorf("expected %d values and 2 omitted. got %v", collections.DefaultLogLimit, attrs)
+	}
+}
@@ -0,0 +1,33 @@
+package slice
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Value implements driver.Valuer, storing the collection as a JSON array. Nil
+// collections are stored as NULL.
+func (c Collection[V]) Value() (driver.Value, error) {
+	if c == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(c)
+}
+
+// Scan implements sql.Scanner, reading collections stored by Value. NULL is read as a
+// nil collection.
+func (c *Collection[V]) Scan(src any) error {
+	switch data := src.(type) {
+	case nil:
+		*c = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(data, c)
+	case string:
+		return json.Unmarshal([]byte(data), c)
+	}
+
+	return fmt.Errorf("slice: can't scan %T into %T", src, *c)
+}
@@ -0,0 +1,66 @@
+package slice
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/thefuga/go-collections/internal"
+)
+
+// Table renders the collection as an aligned text table. Struct values (or pointers
+// to structs) get one column per exported field, named by the `table` tag or by the
+// field name when untagged. Fields tagged with "-" are skipped. Any other value is
+// printed in a single "Value" column. Nil pointers are printed as empty rows.
+func (c Collection[V]) Table() string {
+	t := reflect.TypeOf((*V)(nil)).Elem()
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	var (
+		builder strings.Builder
+		writer  = tabwriter.NewWriter(&builder, 0, 0, 2, ' ', 0)
+	)
+
+	if t.Kind() != reflect.Struct {
+		fmt.Fprintln(writer, "Value")
+
+		for _, v := range c {
+			fmt.Fprintf(writer, "%v\n", v)
+		}
+
+		writer.Flush()
+		return builder.String()
+	}
+
+	fields := internal.Fields(t, "table")
+	columns := make([]string, len(fields))
+
+	for i, field := range fields {
+		columns[i] = field.Name
+	}
+
+	fmt.Fprintln(writer, strings.Join(columns, "\t"))
+
+	for _, v := range c {
+		value := reflect.ValueOf(&v).Elem()
+		if value.Kind() == reflect.Pointer {
+			value = value.Elem()
+		}
+
+		for i, field := range fields {
+			if value.IsValid() {
+				columns[i] = fmt.Sprint(value.Field(field.Index).Interface())
+			} else {
+				columns[i] = ""
+			}
+		}
+
+		fmt.Fprintln(writer, strings.Join(columns, "\t"))
+	}
+
+	writer.Flush()
+	return builder.String()
+}
@@ -0,0 +1,63 @@
+package deque
+
+import (
+	"testing"
+
+	"github.com/thefuga/go-collections"
+	"github.com/thefuga/go-collections/deque"
+	"github.com/thefuga/go-collections/tests/benchmark"
+)
+
+var result int
+
+func BenchmarkDequePushFront(b *testing.B) {
+	d := deque.Collect(benchmark.BuildIntSlice()...)
+
+	for n := 0; n < b.N; n++ {
+		d.PushFront(n)
+	}
+}
+
+func BenchmarkSlicePrepend(b *testing.B) {
+	slice := benchmark.BuildIntSlice()
+
+	for n := 0; n < b.N; n++ {
+		slice = collections.Prepend(slice, n)
+	}
+}
+
+func BenchmarkDequeQueue(b *testing.B) {
+	d := deque.Collect(benchmark.BuildIntSlice()...)
+
+	for n := 0; n < b.N; n++ {
+		d.PushBack(n)
+		result = d.PopFront()
+	}
+}
+
+func BenchmarkSliceQueue(b *testing.B) {
+	slice := benchmark.BuildIntSlice()
+
+	for n := 0; n < b.N; n++ {
+		slice = append(slice, n)
+		result = collections.Shift(&slice)
+	}
+}
+
+func BenchmarkDequePushFrontPopBack(b *testing.B) {
+	d := deque.Collect(benchmark.BuildIntSlice()...)
+
+	for n := 0; n < b.N; n++ {
+		d.PushFront(n)
+		result = d.PopBack()
+	}
+}
+
+func BenchmarkSlicePrependPop(b *testing.B) {
+	slice := benchmark.BuildIntSlice()
+
+	for n := 0; n < b.N; n++ {
+		slice = collections.Prepend(slice, n)
+		result = collections.Pop(&slice)
+	}
+}
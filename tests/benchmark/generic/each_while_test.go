@@ -0,0 +1,46 @@
+package generic
+
+import (
+	"testing"
+
+	. "github.com/thefuga/go-collections"
+	"github.com/thefuga/go-collections/errors"
+	"github.com/thefuga/go-collections/tests/benchmark"
+)
+
+var eachFound bool
+
+func BenchmarkEachFindingFirst(b *testing.B) {
+	slice := benchmark.BuildIntSlice()
+
+	for n := 0; n < b.N; n++ {
+		Each(func(_ int, v int) {
+			if v == 0 {
+				eachFound = true
+			}
+		}, slice)
+	}
+}
+
+func BenchmarkEachWhileFindingFirst(b *testing.B) {
+	slice := benchmark.BuildIntSlice()
+
+	for n := 0; n < b.N; n++ {
+		EachWhile(func(_ int, v int) bool {
+			return v != 0
+		}, slice)
+	}
+}
+
+func BenchmarkEachEFindingFirst(b *testing.B) {
+	slice := benchmark.BuildIntSlice()
+
+	for n := 0; n < b.N; n++ {
+		_ = EachE(func(_ int, v int) error {
+			if v == 0 {
+				return errors.Stop
+			}
+			return nil
+		}, slice)
+	}
+}
@@ -0,0 +1,26 @@
+package generic
+
+import (
+	"testing"
+
+	. "github.com/thefuga/go-collections"
+	"github.com/thefuga/go-collections/tests/benchmark"
+)
+
+func BenchmarkInnerJoin(b *testing.B) {
+	slice := benchmark.BuildIntSlice()
+	identity := func(v int) int { return v }
+
+	for n := 0; n < b.N; n++ {
+		InnerJoin(slice, slice, identity, identity)
+	}
+}
+
+func BenchmarkMergeJoin(b *testing.B) {
+	slice := benchmark.BuildIntSlice()
+	identity := func(v int) int { return v }
+
+	for n := 0; n < b.N; n++ {
+		MergeJoin(slice, slice, identity, identity)
+	}
+}
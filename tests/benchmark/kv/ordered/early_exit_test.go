@@ -0,0 +1,73 @@
+package ordered
+
+import (
+	"testing"
+
+	"github.com/thefuga/go-collections"
+	"github.com/thefuga/go-collections/kv/ordered"
+	"github.com/thefuga/go-collections/tests/benchmark"
+)
+
+var (
+	earlyExitCollection = ordered.CollectSlice(benchmark.BuildIntSlice())
+	earlyExitResult     bool
+)
+
+func BenchmarkFirstOrFailFirstItem(b *testing.B) {
+	var r bool
+
+	for n := 0; n < b.N; n++ {
+		_, _, err := earlyExitCollection.FirstOrFail(collections.KeyEquals(0))
+		r = err == nil
+	}
+
+	earlyExitResult = r
+}
+
+func BenchmarkFirstOrFailFullScan(b *testing.B) {
+	var r bool
+
+	for n := 0; n < b.N; n++ {
+		_, _, err := earlyExitCollection.FirstOrFail(collections.KeyEquals(-1))
+		r = err == nil
+	}
+
+	earlyExitResult = r
+}
+
+func BenchmarkEveryFirstMismatch(b *testing.B) {
+	var r bool
+
+	for n := 0; n < b.N; n++ {
+		r = earlyExitCollection.Every(collections.KeyEquals(-1))
+	}
+
+	earlyExitResult = r
+}
+
+func BenchmarkEachFirstItem(b *testing.B) {
+	var r bool
+
+	for n := 0; n < b.N; n++ {
+		earlyExitCollection.Each(func(k, _ int) {
+			if k == 0 {
+				r = true
+			}
+		})
+	}
+
+	earlyExitResult = r
+}
+
+func BenchmarkEachWhileFirstItem(b *testing.B) {
+	var r bool
+
+	for n := 0; n < b.N; n++ {
+		earlyExitCollection.EachWhile(func(k, _ int) bool {
+			r = k == 0
+			return !r
+		})
+	}
+
+	earlyExitResult = r
+}
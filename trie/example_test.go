@@ -0,0 +1,27 @@
+package trie
+
+import "fmt"
+
+func ExampleTrie_WithPrefix() {
+	t := New[int]().Put("car", 1).Put("cart", 2).Put("cat", 3).Put("dog", 4)
+
+	fmt.Println(t.WithPrefix("car").Keys())
+	fmt.Println(t.CountPrefix("ca"))
+	// Output:
+	// [car cart]
+	// 3
+}
+
+func ExampleTrie_LongestPrefixMatch() {
+	routes := New[string]().Put("/", "index").Put("/users", "users")
+
+	fmt.Println(routes.LongestPrefixMatch("/users/42"))
+	// Output:
+	// /users users
+}
+
+func ExampleTrie_Format() {
+	fmt.Printf("%v\n", New[int]().Put("cat", 2).Put("car", 1))
+	// Output:
+	// {car:1 cat:2}
+}
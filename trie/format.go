@@ -0,0 +1,30 @@
+package trie
+
+import (
+	"fmt"
+
+	"github.com/thefuga/go-collections/internal"
+)
+
+// String returns the trie formatted with %v.
+func (t *Trie[V]) String() string { return fmt.Sprint(t) }
+
+// Format implements fmt.Formatter, printing the pairs in lexicographic key order. %v
+// prints the pairs in a single line, %+v prints one pair per line and %#v prints the
+// trie in Go syntax. The precision limits the number of pairs printed (e.g. %.10v).
+func (t *Trie[V]) Format(s fmt.State, verb rune) {
+	values := t.ToOrdered()
+
+	internal.Format(s, verb, t, internal.Formatter{
+		Open:  "{",
+		Close: "}",
+		Keyed: true,
+		Each: func(f func(k, v any) bool) {
+			for _, k := range values.Keys() {
+				if !f(k, values.Get(k)) {
+					return
+				}
+			}
+		},
+	})
+}
@@ -0,0 +1,284 @@
+// Package trie provides a string keyed collection supporting prefix lookups.
+package trie
+
+import (
+	"sort"
+
+	"github.com/thefuga/go-collections/errors"
+	"github.com/thefuga/go-collections/kv"
+	"github.com/thefuga/go-collections/kv/ordered"
+	"github.com/thefuga/go-collections/slice"
+)
+
+// Trie is a prefix tree mapping strings to values. Keys are split in bytes, so
+// iteration follows the lexicographic order of their bytes. Put, Get and Forget run
+// in O(len(k)) regardless of the number of keys. The zero value is an empty trie
+// ready to use.
+type Trie[V any] struct {
+	root node[V]
+}
+
+type node[V any] struct {
+	children []child[V]
+	value    V
+	hasValue bool
+	// count is the number of values held by the node and its descendants.
+	count int
+}
+
+// child is an edge of the trie. Children are kept sorted by b.
+type child[V any] struct {
+	b    byte
+	node *node[V]
+}
+
+// New makes an empty trie.
+func New[V any]() *Trie[V] { return &Trie[V]{} }
+
+// FromKV makes a trie holding the key-value pairs of the kv collection.
+func FromKV[V any](c kv.Collection[string, V]) *Trie[V] {
+	t := New[V]()
+
+	c.Each(func(k string, v V) {
+		t.Put(k, v)
+	})
+
+	return t
+}
+
+// FromOrdered makes a trie holding the key-value pairs of the ordered collection.
+func FromOrdered[V any](c ordered.Collection[string, V]) *Trie[V] {
+	t := New[V]()
+
+	c.Each(func(k string, v V) {
+		t.Put(k, v)
+	})
+
+	return t
+}
+
+// Count returns the number of keys on the trie.
+func (t *Trie[V]) Count() int { return t.root.count }
+
+// IsEmpty checks if the trie is empty.
+func (t *Trie[V]) IsEmpty() bool { return t.root.count == 0 }
+
+// Put associates v to k, replacing any value k held.
+func (t *Trie[V]) Put(k string, v V) *Trie[V] {
+	if existing := t.find(k); existing != nil && existing.hasValue {
+		existing.value = v
+		return t
+	}
+
+	n := &t.root
+	n.count++
+
+	for i := 0; i < len(k); i++ {
+		n = n.child(k[i], true)
+		n.count++
+	}
+
+	n.value, n.hasValue = v, true
+
+	return t
+}
+
+// Get calls GetE, omitting the error.
+func (t *Trie[V]) Get(k string) V {
+	v, _ := t.GetE(k)
+	return v
+}
+
+// GetE returns the value associated to k. Should k not exist, an instance of
+// errors.KeyNotFoundError is returned.
+func (t *Trie[V]) GetE(k string) (V, error) {
+	n := t.find(k)
+	if n == nil || !n.hasValue {
+		return *new(V), errors.NewKeyNotFoundError(k)
+	}
+
+	return n.value, nil
+}
+
+// Has checks if k exists on the trie.
+func (t *Trie[V]) Has(k string) bool {
+	n := t.find(k)
+	return n != nil && n.hasValue
+}
+
+// Forget calls ForgetE, omitting the error.
+func (t *Trie[V]) Forget(k string) *Trie[V] {
+	_ = t.ForgetE(k)
+	return t
+}
+
+// ForgetE removes k from the trie, pruning the nodes left without values. Should k
+// not exist, an instance of errors.KeyNotFoundError is returned.
+func (t *Trie[V]) ForgetE(k string) error {
+	if !t.Has(k) {
+		return errors.NewKeyNotFoundError(k)
+	}
+
+	n := &t.root
+	n.count--
+
+	for i := 0; i < len(k); i++ {
+		next := n.child(k[i], false)
+		next.count--
+
+		if next.count == 0 {
+			n.removeChild(k[i])
+			return nil
+		}
+
+		n = next
+	}
+
+	n.value, n.hasValue = *new(V), false
+
+	return nil
+}
+
+// CountPrefix returns the number of keys starting with prefix.
+func (t *Trie[V]) CountPrefix(prefix string) int {
+	if n := t.find(prefix); n != nil {
+		return n.count
+	}
+
+	return 0
+}
+
+// WithPrefix returns an ordered collection holding the key-value pairs which keys
+// start with prefix, in lexicographic order.
+func (t *Trie[V]) WithPrefix(prefix string) ordered.Collection[string, V] {
+	c := ordered.CollectMap(map[string]V{})
+
+	t.EachPrefix(prefix, func(k string, v V) {
+		c.Put(k, v)
+	})
+
+	return c
+}
+
+// EachPrefix calls f with every key-value pair which key starts with prefix, in
+// lexicographic order.
+func (t *Trie[V]) EachPrefix(prefix string, f func(k string, v V)) *Trie[V] {
+	if n := t.find(prefix); n != nil {
+		n.each([]byte(prefix), f)
+	}
+
+	return t
+}
+
+// Each calls f with every key-value pair, in lexicographic order.
+func (t *Trie[V]) Each(f func(k string, v V)) *Trie[V] {
+	return t.EachPrefix("", f)
+}
+
+// LongestPrefixMatch calls LongestPrefixMatchE, omitting the error.
+func (t *Trie[V]) LongestPrefixMatch(s string) (string, V) {
+	k, v, _ := t.LongestPrefixMatchE(s)
+	return k, v
+}
+
+// LongestPrefixMatchE returns the longest key which is a prefix of s, and its value.
+// Should no key be a prefix of s, an instance of errors.ValueNotFoundError is returned.
+func (t *Trie[V]) LongestPrefixMatchE(s string) (string, V, error) {
+	var (
+		n     = &t.root
+		found = -1
+		value V
+	)
+
+	for i := 0; n != nil; i++ {
+		if n.hasValue {
+			found, value = i, n.value
+		}
+
+		if i == len(s) {
+			break
+		}
+
+		n = n.child(s[i], false)
+	}
+
+	if found < 0 {
+		return "", value, errors.NewValueNotFoundError()
+	}
+
+	return s[:found], value, nil
+}
+
+// Keys returns the keys of the trie in lexicographic order.
+func (t *Trie[V]) Keys() slice.Collection[string] {
+	keys := make(slice.Collection[string], 0, t.Count())
+
+	t.Each(func(k string, _ V) {
+		keys = keys.Push(k)
+	})
+
+	return keys
+}
+
+// ToKV makes a new kv.Collection holding the key-value pairs of the trie.
+func (t *Trie[V]) ToKV() kv.Collection[string, V] {
+	c := make(kv.Collection[string, V], t.Count())
+
+	t.Each(func(k string, v V) {
+		c.Put(k, v)
+	})
+
+	return c
+}
+
+// ToOrdered makes a new ordered.Collection holding the key-value pairs of the trie,
+// in lexicographic order.
+func (t *Trie[V]) ToOrdered() ordered.Collection[string, V] {
+	return t.WithPrefix("")
+}
+
+func (t *Trie[V]) find(k string) *node[V] {
+	n := &t.root
+
+	for i := 0; i < len(k) && n != nil; i++ {
+		n = n.child(k[i], false)
+	}
+
+	return n
+}
+
+// child returns the child reached by b, creating it when create is true.
+func (n *node[V]) child(b byte, create bool) *node[V] {
+	i := sort.Search(len(n.children), func(i int) bool { return n.children[i].b >= b })
+
+	if i < len(n.children) && n.children[i].b == b {
+		return n.children[i].node
+	}
+
+	if !create {
+		return nil
+	}
+
+	created := &node[V]{}
+
+	n.children = append(n.children, child[V]{})
+	copy(n.children[i+1:], n.children[i:])
+	n.children[i] = child[V]{b: b, node: created}
+
+	return created
+}
+
+func (n *node[V]) removeChild(b byte) {
+	i := sort.Search(len(n.children), func(i int) bool { return n.children[i].b >= b })
+	n.children = append(n.children[:i], n.children[i+1:]...)
+}
+
+func (n *node[V]) each(key []byte, f func(k string, v V)) {
+	if n.hasValue {
+		f(string(key), n.value)
+	}
+
+	for _, c := range n.children {
+		c.node.each(append(key, c.b), f)
+	}
+}
@@ -0,0 +1,140 @@
+package trie
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thefuga/go-collections/kv"
+	"github.com/thefuga/go-collections/kv/ordered"
+	"github.com/thefuga/go-collections/slice"
+)
+
+func words() *Trie[int] {
+	return FromKV(kv.CollectMap(map[string]int{
+		"tea": 1, "ten": 2, "team": 3, "to": 4, "inn": 5, "in": 6, "": 7,
+	}))
+}
+
+func TestPutAndGet(t *testing.T) {
+	tr := words()
+
+	if tr.Count() != 7 {
+		t.Errorf("expected count to be 7. got %d", tr.Count())
+	}
+
+	tr.Put("tea", 10)
+
+	if tr.Get("tea") != 10 || tr.Count() != 7 {
+		t.Error("expected tea to be replaced")
+	}
+
+	if _, err := tr.GetE("te"); err == nil || err.Error() != "key 'te' not found" {
+		t.Errorf("expected a key not found error. got %v", err)
+	}
+
+	if tr.Get("") != 7 || tr.Has("tex") {
+		t.Error("unexpected lookups")
+	}
+}
+
+func TestForget(t *testing.T) {
+	tr := words()
+
+	if err := tr.ForgetE("te"); err == nil {
+		t.Error("forgetting a prefix which is not a key must return an error")
+	}
+
+	tr.Forget("team").Forget("in")
+
+	if tr.Has("team") || tr.Has("in") || !tr.Has("tea") || !tr.Has("inn") {
+		t.Errorf("unexpected keys %v", tr.Keys())
+	}
+
+	if tr.CountPrefix("te") != 2 || tr.CountPrefix("i") != 1 {
+		t.Errorf("unexpected prefix counts after forgetting")
+	}
+
+	for _, k := range tr.Keys() {
+		tr.Forget(k)
+	}
+
+	if !tr.IsEmpty() || len(tr.root.children) != 0 {
+		t.Error("expected every node to be pruned")
+	}
+}
+
+func TestWithPrefix(t *testing.T) {
+	tr := words()
+
+	testCases := []struct {
+		description string
+		prefix      string
+		expected    slice.Collection[string]
+	}{
+		{"every key", "", slice.Collect("", "in", "inn", "tea", "team", "ten", "to")},
+		{"shared prefix", "te", slice.Collect("tea", "team", "ten")},
+		{"prefix which is a key", "in", slice.Collect("in", "inn")},
+		{"missing prefix", "x", slice.Collection[string]{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			matched := tr.WithPrefix(tc.prefix)
+
+			if !reflect.DeepEqual(matched.Keys(), tc.expected) {
+				t.Errorf("expected %v. got %v", tc.expected, matched.Keys())
+			}
+
+			if tr.CountPrefix(tc.prefix) != len(tc.expected) {
+				t.Errorf("expected prefix count to be %d. got %d", len(tc.expected), tr.CountPrefix(tc.prefix))
+			}
+		})
+	}
+}
+
+func TestLongestPrefixMatch(t *testing.T) {
+	tr := New[string]().Put("/api", "api").Put("/api/users", "users").Put("/", "root")
+
+	testCases := []struct {
+		description string
+		s           string
+		key         string
+		found       bool
+	}{
+		{"exact key", "/api/users", "/api/users", true},
+		{"longer than a key", "/api/users/1", "/api/users", true},
+		{"between keys", "/api/use", "/api", true},
+		{"shortest key", "/docs", "/", true},
+		{"no match", "api", "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			k, v, err := tr.LongestPrefixMatchE(tc.s)
+
+			if tc.found != (err == nil) {
+				t.Fatalf("unexpected error %v", err)
+			}
+
+			if k != tc.key || (tc.found && v != tr.Get(tc.key)) {
+				t.Errorf("expected %s. got %s (%s)", tc.key, k, v)
+			}
+		})
+	}
+}
+
+func TestConversions(t *testing.T) {
+	c := ordered.CollectMap(map[string]int{})
+	c.Put("b", 2)
+	c.Put("a", 1)
+
+	tr := FromOrdered(c)
+
+	if expected := (kv.Collection[string, int]{"a": 1, "b": 2}); !reflect.DeepEqual(tr.ToKV(), expected) {
+		t.Errorf("expected %v. got %v", expected, tr.ToKV())
+	}
+
+	if expected := slice.Collect("a", "b"); !reflect.DeepEqual(tr.ToOrdered().Keys(), expected) {
+		t.Errorf("expected %v. got %v", expected, tr.ToOrdered().Keys())
+	}
+}